@@ -14,6 +14,7 @@ import (
 	"github.com/orbiqd/orbiqd-projectkit/internal/pkg/ai/instruction"
 	mcpInternal "github.com/orbiqd/orbiqd-projectkit/internal/pkg/ai/mcp"
 	"github.com/orbiqd/orbiqd-projectkit/internal/pkg/ai/skill"
+	toolInternal "github.com/orbiqd/orbiqd-projectkit/internal/pkg/ai/tool"
 	"github.com/orbiqd/orbiqd-projectkit/internal/pkg/ai/workflow"
 	"github.com/orbiqd/orbiqd-projectkit/internal/pkg/doc/standard"
 	"github.com/orbiqd/orbiqd-projectkit/internal/pkg/git"
@@ -77,12 +78,27 @@ func main() {
 		runtime.Fatalf("bind config provider: %v", err)
 	}
 
+	sourceCache, err := source.NewCacheFromConfig(cmd.Source)
+	if err != nil {
+		runtime.Fatalf("create source cache: %v", err)
+	}
+
 	sourceDriverRepository := source.NewDriverRepository()
 	err = sourceDriverRepository.RegisterDriver(source.NewLocalDriver())
 	if err != nil {
 		runtime.Fatalf("register local driver: %v", err)
 	}
 
+	err = sourceDriverRepository.RegisterDriver(source.NewGitDriver(source.WithGitCache(sourceCache)))
+	if err != nil {
+		runtime.Fatalf("register git driver: %v", err)
+	}
+
+	err = sourceDriverRepository.RegisterDriver(source.NewArchiveDriver(source.WithArchiveCache(sourceCache)))
+	if err != nil {
+		runtime.Fatalf("register archive driver: %v", err)
+	}
+
 	sourceResolver := source.NewResolver(sourceDriverRepository)
 	runtime.BindTo(sourceResolver, (*sourceAPI.Resolver)(nil))
 
@@ -110,12 +126,24 @@ func main() {
 		return
 	}
 
+	err = runtime.BindSingletonProvider(workflow.NewEngineProvider())
+	if err != nil {
+		runtime.Fatalf("bind workflow service provider: %v", err)
+		return
+	}
+
 	err = runtime.BindSingletonProvider(mcpInternal.NewFsRepositoryProvider())
 	if err != nil {
 		runtime.Fatalf("bind mcp repository provider: %v", err)
 		return
 	}
 
+	err = runtime.BindSingletonProvider(toolInternal.NewFsRepositoryProvider())
+	if err != nil {
+		runtime.Fatalf("bind tool repository provider: %v", err)
+		return
+	}
+
 	err = runtime.BindSingletonProvider(agent.NewRegistryProvider(
 		func(rootFs afero.Fs) agentAPI.Provider { return claude.NewProvider(rootFs) },
 		func(rootFs afero.Fs) agentAPI.Provider { return codex.NewProvider(rootFs) },
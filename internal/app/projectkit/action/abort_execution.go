@@ -0,0 +1,46 @@
+package action
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+type AbortExecutionAction struct {
+	workflowRepository workflowAPI.Repository
+	workflowService    workflowAPI.Service
+	executionId        workflowAPI.ExecutionId
+	output             io.Writer
+	json               bool
+}
+
+func NewAbortExecutionAction(workflowRepository workflowAPI.Repository, workflowService workflowAPI.Service, executionId workflowAPI.ExecutionId, output io.Writer, json bool) *AbortExecutionAction {
+	return &AbortExecutionAction{
+		workflowRepository: workflowRepository,
+		workflowService:    workflowService,
+		executionId:        executionId,
+		output:             output,
+		json:               json,
+	}
+}
+
+func (action *AbortExecutionAction) Run() error {
+	execution, err := action.workflowService.Abort(humanActor(), action.executionId)
+	if err != nil {
+		return fmt.Errorf("abort execution: %w", err)
+	}
+
+	slog.Info("Workflow execution aborted.",
+		slog.String("executionId", string(execution.Id)),
+		slog.String("workflowId", string(execution.WorkflowId)),
+		slog.String("stepId", execution.StepId),
+	)
+
+	if err := writeExecutionStatus(action.output, action.workflowRepository, *execution, action.json); err != nil {
+		return fmt.Errorf("abort execution: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package action
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+func abortedTestExecution() *workflowAPI.Execution {
+	return &workflowAPI.Execution{
+		Id:              "exec-1",
+		WorkflowId:      "release",
+		WorkflowVersion: "1.2.0",
+		Status:          workflowAPI.ExecutionStatusAborted,
+		StateValues:     map[string]any{},
+		StepId:          "publish",
+	}
+}
+
+func TestAbortExecutionActionRun_WhenActive_ThenPrintsStatus(t *testing.T) {
+	t.Parallel()
+
+	workflow := actionTestWorkflow()
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetWorkflowVersion(workflowAPI.WorkflowId("release"), "1.2.0").Return(&workflow, nil)
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().Abort(humanActor(), workflowAPI.ExecutionId("exec-1")).Return(abortedTestExecution(), nil)
+
+	var output bytes.Buffer
+	err := NewAbortExecutionAction(mockRepository, mockService, "exec-1", &output, false).Run()
+
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "Execution:  exec-1\n")
+	assert.Contains(t, output.String(), "Status:     aborted\n")
+}
+
+func TestAbortExecutionActionRun_WhenJSON_ThenPrintsStatusAsJSON(t *testing.T) {
+	t.Parallel()
+
+	workflow := actionTestWorkflow()
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetWorkflowVersion(workflowAPI.WorkflowId("release"), "1.2.0").Return(&workflow, nil)
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().Abort(humanActor(), workflowAPI.ExecutionId("exec-1")).Return(abortedTestExecution(), nil)
+
+	var output bytes.Buffer
+	err := NewAbortExecutionAction(mockRepository, mockService, "exec-1", &output, true).Run()
+
+	require.NoError(t, err)
+	var result map[string]any
+	require.NoError(t, json.Unmarshal(output.Bytes(), &result))
+	assert.Equal(t, "exec-1", result["id"])
+	assert.Equal(t, "aborted", result["status"])
+	assert.Equal(t, float64(2), result["stepNumber"])
+}
+
+func TestAbortExecutionActionRun_WhenAbortFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().Abort(humanActor(), workflowAPI.ExecutionId("exec-1")).Return(nil, workflowAPI.ErrExecutionFinished)
+
+	err := NewAbortExecutionAction(workflowAPI.NewMockRepository(t), mockService, "exec-1", &bytes.Buffer{}, false).Run()
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, workflowAPI.ErrExecutionFinished))
+	assert.Contains(t, err.Error(), "abort execution")
+}
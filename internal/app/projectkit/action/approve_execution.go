@@ -0,0 +1,35 @@
+package action
+
+import (
+	"fmt"
+	"log/slog"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+type ApproveExecutionAction struct {
+	workflowService workflowAPI.Service
+	executionId     workflowAPI.ExecutionId
+}
+
+func NewApproveExecutionAction(workflowService workflowAPI.Service, executionId workflowAPI.ExecutionId) *ApproveExecutionAction {
+	return &ApproveExecutionAction{
+		workflowService: workflowService,
+		executionId:     executionId,
+	}
+}
+
+func (action *ApproveExecutionAction) Run() error {
+	execution, err := action.workflowService.Approve(humanActor(), action.executionId)
+	if err != nil {
+		return fmt.Errorf("approve execution: %w", err)
+	}
+
+	slog.Info("Workflow execution approved.",
+		slog.String("executionId", string(execution.Id)),
+		slog.String("workflowId", string(execution.WorkflowId)),
+		slog.String("stepId", execution.StepId),
+	)
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package action
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+func TestApproveExecutionActionRun_WhenApproved_ThenReturnsNil(t *testing.T) {
+	t.Parallel()
+
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().Approve(humanActor(), workflowAPI.ExecutionId("exec-1")).Return(&workflowAPI.Execution{
+		Id:         "exec-1",
+		WorkflowId: "release",
+		Status:     workflowAPI.ExecutionStatusRunning,
+		StepId:     "publish",
+	}, nil)
+
+	err := NewApproveExecutionAction(mockService, "exec-1").Run()
+
+	require.NoError(t, err)
+}
+
+func TestApproveExecutionActionRun_WhenApproveFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().Approve(humanActor(), workflowAPI.ExecutionId("exec-1")).Return(nil, workflowAPI.ErrExecutionNotAwaitingApproval)
+
+	err := NewApproveExecutionAction(mockService, "exec-1").Run()
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, workflowAPI.ErrExecutionNotAwaitingApproval))
+	assert.Contains(t, err.Error(), "approve execution")
+}
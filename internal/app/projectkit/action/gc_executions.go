@@ -0,0 +1,71 @@
+package action
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/coder/quartz"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+// GCExecutionsAction removes the finished workflow executions the retention does not keep.
+type GCExecutionsAction struct {
+	workflowRepository workflowAPI.Repository
+	retention          *workflowAPI.RetentionConfig
+	clock              quartz.Clock
+	dryRun             bool
+}
+
+func NewGCExecutionsAction(
+	workflowRepository workflowAPI.Repository,
+	retention *workflowAPI.RetentionConfig,
+	clock quartz.Clock,
+	dryRun bool,
+) *GCExecutionsAction {
+	return &GCExecutionsAction{
+		workflowRepository: workflowRepository,
+		retention:          retention,
+		clock:              clock,
+		dryRun:             dryRun,
+	}
+}
+
+func (action *GCExecutionsAction) Run() error {
+	if action.retention == nil {
+		slog.Info("No execution retention configured, nothing to collect.")
+		return nil
+	}
+
+	executions, err := action.workflowRepository.GetAllExecutions()
+	if err != nil {
+		return fmt.Errorf("get all executions: %w", err)
+	}
+
+	expired, err := action.retention.Expired(executions, action.clock.Now())
+	if err != nil {
+		return fmt.Errorf("select expired executions: %w", err)
+	}
+
+	for _, execution := range expired {
+		if !action.dryRun {
+			if err := action.workflowRepository.RemoveExecution(execution.Id); err != nil {
+				return fmt.Errorf("remove execution %s: %w", execution.Id, err)
+			}
+		}
+
+		slog.Info("Workflow execution removed.",
+			slog.String("executionId", string(execution.Id)),
+			slog.String("workflowId", string(execution.WorkflowId)),
+			slog.String("status", string(execution.Status)),
+			slog.Bool("dryRun", action.dryRun),
+		)
+	}
+
+	slog.Info("Workflow executions collected.",
+		slog.Int("count", len(expired)),
+		slog.Int("keptCount", len(executions)-len(expired)),
+		slog.Bool("dryRun", action.dryRun),
+	)
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package action
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/coder/quartz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+func gcTestExecutions(now time.Time) []workflowAPI.Execution {
+	completedAt := now.Add(-48 * time.Hour)
+
+	return []workflowAPI.Execution{
+		{Id: "exec-1", WorkflowId: "release", Status: workflowAPI.ExecutionStatusCompleted, CompletedAt: &completedAt},
+		{Id: "exec-2", WorkflowId: "release", Status: workflowAPI.ExecutionStatusRunning},
+	}
+}
+
+func TestGCExecutionsActionRun_WhenExecutionsExpired_ThenRemovesThem(t *testing.T) {
+	t.Parallel()
+
+	clock := quartz.NewMock(t)
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetAllExecutions().Return(gcTestExecutions(clock.Now()), nil)
+	mockRepository.EXPECT().RemoveExecution(workflowAPI.ExecutionId("exec-1")).Return(nil)
+
+	err := NewGCExecutionsAction(mockRepository, &workflowAPI.RetentionConfig{MaxAge: "24h"}, clock, false).Run()
+
+	require.NoError(t, err)
+}
+
+func TestGCExecutionsActionRun_WhenDryRun_ThenKeepsExecutions(t *testing.T) {
+	t.Parallel()
+
+	clock := quartz.NewMock(t)
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetAllExecutions().Return(gcTestExecutions(clock.Now()), nil)
+
+	err := NewGCExecutionsAction(mockRepository, &workflowAPI.RetentionConfig{MaxAge: "24h"}, clock, true).Run()
+
+	require.NoError(t, err)
+}
+
+func TestGCExecutionsActionRun_WhenRetentionNotConfigured_ThenDoesNothing(t *testing.T) {
+	t.Parallel()
+
+	err := NewGCExecutionsAction(workflowAPI.NewMockRepository(t), nil, quartz.NewMock(t), false).Run()
+
+	require.NoError(t, err)
+}
+
+func TestGCExecutionsActionRun_WhenRemoveFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	clock := quartz.NewMock(t)
+	removeErr := errors.New("disk failure")
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetAllExecutions().Return(gcTestExecutions(clock.Now()), nil)
+	mockRepository.EXPECT().RemoveExecution(workflowAPI.ExecutionId("exec-1")).Return(removeErr)
+
+	err := NewGCExecutionsAction(mockRepository, &workflowAPI.RetentionConfig{MaxAge: "24h"}, clock, false).Run()
+
+	require.ErrorIs(t, err, removeErr)
+	assert.Contains(t, err.Error(), "remove execution exec-1")
+}
+
+func TestGCExecutionsActionRun_WhenMaxAgeInvalid_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetAllExecutions().Return(nil, nil)
+
+	err := NewGCExecutionsAction(mockRepository, &workflowAPI.RetentionConfig{MaxAge: "a month"}, quartz.NewMock(t), false).Run()
+
+	require.ErrorIs(t, err, workflowAPI.ErrRetentionMaxAgeInvalid)
+}
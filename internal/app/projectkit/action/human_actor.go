@@ -0,0 +1,17 @@
+package action
+
+import (
+	"os/user"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+// humanActor identifies the user running projectkit as the actor of workflow changes made from the command line.
+func humanActor() workflowAPI.Actor {
+	actor := workflowAPI.Actor{Kind: workflowAPI.ActorKindHuman}
+	if current, err := user.Current(); err == nil {
+		actor.Name = current.Username
+	}
+
+	return actor
+}
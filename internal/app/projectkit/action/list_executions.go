@@ -0,0 +1,55 @@
+package action
+
+import (
+	"fmt"
+	"io"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+// ListExecutionsAction lists the executions of the workflow with the given ID, or of all workflows when the ID is
+// empty.
+type ListExecutionsAction struct {
+	workflowRepository workflowAPI.Repository
+	workflowId         workflowAPI.WorkflowId
+	output             io.Writer
+	json               bool
+}
+
+func NewListExecutionsAction(workflowRepository workflowAPI.Repository, workflowId workflowAPI.WorkflowId, output io.Writer, json bool) *ListExecutionsAction {
+	return &ListExecutionsAction{
+		workflowRepository: workflowRepository,
+		workflowId:         workflowId,
+		output:             output,
+		json:               json,
+	}
+}
+
+func (action *ListExecutionsAction) Run() error {
+	var executions []workflowAPI.Execution
+	var err error
+	if action.workflowId == "" {
+		executions, err = action.workflowRepository.GetAllExecutions()
+	} else {
+		executions, err = action.workflowRepository.ListExecutions(action.workflowId)
+	}
+	if err != nil {
+		return fmt.Errorf("list executions: %w", err)
+	}
+
+	if action.json {
+		err = writeJSON(action.output, executions)
+	} else {
+		rows := make([][]string, 0, len(executions))
+		for _, execution := range executions {
+			rows = append(rows, []string{string(execution.Id), string(execution.WorkflowId), string(execution.Status), execution.StepId})
+		}
+
+		err = writeTable(action.output, []string{"ID", "WORKFLOW", "STATUS", "STEP"}, rows)
+	}
+	if err != nil {
+		return fmt.Errorf("list executions: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package action
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+func actionTestExecutions() []workflowAPI.Execution {
+	return []workflowAPI.Execution{
+		{Id: "exec-1", WorkflowId: "release", Status: workflowAPI.ExecutionStatusRunning, StateValues: map[string]any{}, StepId: "prepare"},
+		{Id: "exec-2", WorkflowId: "release", Status: workflowAPI.ExecutionStatusCompleted, StateValues: map[string]any{}, StepId: "publish"},
+	}
+}
+
+func TestListExecutionsActionRun_WhenExecutionsStored_ThenPrintsTable(t *testing.T) {
+	t.Parallel()
+
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetAllExecutions().Return(actionTestExecutions(), nil)
+
+	var output bytes.Buffer
+	err := NewListExecutionsAction(mockRepository, "", &output, false).Run()
+
+	require.NoError(t, err)
+	assert.Equal(t, ""+
+		"ID      WORKFLOW  STATUS     STEP\n"+
+		"exec-1  release   running    prepare\n"+
+		"exec-2  release   completed  publish\n",
+		output.String())
+}
+
+func TestListExecutionsActionRun_WhenJSONRequested_ThenPrintsExecutions(t *testing.T) {
+	t.Parallel()
+
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetAllExecutions().Return(actionTestExecutions(), nil)
+
+	var output bytes.Buffer
+	err := NewListExecutionsAction(mockRepository, "", &output, true).Run()
+
+	require.NoError(t, err)
+	var result []workflowAPI.Execution
+	require.NoError(t, json.Unmarshal(output.Bytes(), &result))
+	assert.Equal(t, actionTestExecutions(), result)
+}
+
+func TestListExecutionsActionRun_WhenWorkflowGiven_ThenListsItsExecutions(t *testing.T) {
+	t.Parallel()
+
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().ListExecutions(workflowAPI.WorkflowId("release")).Return(actionTestExecutions()[:1], nil)
+
+	var output bytes.Buffer
+	err := NewListExecutionsAction(mockRepository, "release", &output, false).Run()
+
+	require.NoError(t, err)
+	assert.Equal(t, ""+
+		"ID      WORKFLOW  STATUS   STEP\n"+
+		"exec-1  release   running  prepare\n",
+		output.String())
+}
+
+func TestListExecutionsActionRun_WhenRepositoryFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetAllExecutions().Return(nil, errors.New("disk failure"))
+
+	err := NewListExecutionsAction(mockRepository, "", &bytes.Buffer{}, false).Run()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "list executions: disk failure")
+}
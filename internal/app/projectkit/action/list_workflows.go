@@ -0,0 +1,71 @@
+package action
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+type workflowSummary struct {
+	ID          workflowAPI.WorkflowId `json:"id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Version     string                 `json:"version"`
+	StepsCount  int                    `json:"stepsCount"`
+	Source      string                 `json:"source,omitempty"`
+}
+
+type ListWorkflowsAction struct {
+	workflowRepository workflowAPI.Repository
+	output             io.Writer
+	json               bool
+}
+
+func NewListWorkflowsAction(workflowRepository workflowAPI.Repository, output io.Writer, json bool) *ListWorkflowsAction {
+	return &ListWorkflowsAction{
+		workflowRepository: workflowRepository,
+		output:             output,
+		json:               json,
+	}
+}
+
+func (action *ListWorkflowsAction) Run() error {
+	workflows, err := action.workflowRepository.GetAllWorkflows()
+	if err != nil {
+		return fmt.Errorf("list workflows: %w", err)
+	}
+
+	summaries := make([]workflowSummary, 0, len(workflows))
+	for _, workflow := range workflows {
+		summary := workflowSummary{
+			ID:          workflow.Metadata.ID,
+			Name:        workflow.Metadata.Name,
+			Description: workflow.Metadata.Description,
+			Version:     workflow.Metadata.Version,
+			StepsCount:  len(workflow.Steps),
+		}
+		if workflow.Provenance != nil {
+			summary.Source = workflow.Provenance.String()
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	if action.json {
+		err = writeJSON(action.output, summaries)
+	} else {
+		rows := make([][]string, 0, len(summaries))
+		for _, summary := range summaries {
+			rows = append(rows, []string{string(summary.ID), summary.Name, summary.Version, strconv.Itoa(summary.StepsCount), summary.Source})
+		}
+
+		err = writeTable(action.output, []string{"ID", "NAME", "VERSION", "STEPS", "SOURCE"}, rows)
+	}
+	if err != nil {
+		return fmt.Errorf("list workflows: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package action
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+)
+
+func actionTestWorkflow() workflowAPI.Workflow {
+	return workflowAPI.Workflow{
+		Metadata: workflowAPI.Metadata{
+			ID:          "release",
+			Name:        "Release",
+			Description: "Release a new version.",
+			Version:     "1.2.0",
+		},
+		Steps: []workflowAPI.Step{
+			{ID: "prepare", Name: "Prepare", Outputs: []string{"version"}},
+			{ID: "publish", Name: "Publish", Approval: &workflowAPI.Approval{Message: "Check the changelog."}},
+		},
+		Provenance: &sourceAPI.Provenance{SourceURI: "local://./rulebooks/general", Path: "ai/workflows/release.yaml"},
+	}
+}
+
+func TestListWorkflowsActionRun_WhenWorkflowsStored_ThenPrintsTable(t *testing.T) {
+	t.Parallel()
+
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetAllWorkflows().Return([]workflowAPI.Workflow{actionTestWorkflow()}, nil)
+
+	var output bytes.Buffer
+	err := NewListWorkflowsAction(mockRepository, &output, false).Run()
+
+	require.NoError(t, err)
+	assert.Equal(t, ""+
+		"ID       NAME     VERSION  STEPS  SOURCE\n"+
+		"release  Release  1.2.0    2      "+actionTestWorkflow().Provenance.String()+"\n",
+		output.String())
+}
+
+func TestListWorkflowsActionRun_WhenJSONRequested_ThenPrintsSummaries(t *testing.T) {
+	t.Parallel()
+
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetAllWorkflows().Return([]workflowAPI.Workflow{actionTestWorkflow()}, nil)
+
+	var output bytes.Buffer
+	err := NewListWorkflowsAction(mockRepository, &output, true).Run()
+
+	require.NoError(t, err)
+	var summaries []workflowSummary
+	require.NoError(t, json.Unmarshal(output.Bytes(), &summaries))
+	require.Len(t, summaries, 1)
+	assert.Equal(t, workflowAPI.WorkflowId("release"), summaries[0].ID)
+	assert.Equal(t, 2, summaries[0].StepsCount)
+}
+
+func TestListWorkflowsActionRun_WhenRepositoryFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetAllWorkflows().Return(nil, errors.New("disk failure"))
+
+	err := NewListWorkflowsAction(mockRepository, &bytes.Buffer{}, false).Run()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "list workflows: disk failure")
+}
@@ -0,0 +1,39 @@
+package action
+
+import (
+	"fmt"
+	"log/slog"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+type MigrateExecutionAction struct {
+	workflowService workflowAPI.Service
+	executionId     workflowAPI.ExecutionId
+	stepIds         map[string]string
+}
+
+func NewMigrateExecutionAction(workflowService workflowAPI.Service, executionId workflowAPI.ExecutionId, stepIds map[string]string) *MigrateExecutionAction {
+	return &MigrateExecutionAction{
+		workflowService: workflowService,
+		executionId:     executionId,
+		stepIds:         stepIds,
+	}
+}
+
+func (action *MigrateExecutionAction) Run() error {
+	execution, err := action.workflowService.Migrate(humanActor(), action.executionId, action.stepIds)
+	if err != nil {
+		return fmt.Errorf("migrate execution: %w", err)
+	}
+
+	slog.Info("Workflow execution migrated.",
+		slog.String("executionId", string(execution.Id)),
+		slog.String("workflowId", string(execution.WorkflowId)),
+		slog.String("workflowVersion", execution.WorkflowVersion),
+		slog.String("stepId", execution.StepId),
+		slog.String("status", string(execution.Status)),
+	)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package action
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+func TestMigrateExecutionActionRun_WhenMigrated_ThenPassesStepIds(t *testing.T) {
+	t.Parallel()
+
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().Migrate(humanActor(), workflowAPI.ExecutionId("exec-1"), map[string]string{"prepare": "collect"}).Return(&workflowAPI.Execution{
+		Id:              "exec-1",
+		WorkflowId:      "release",
+		WorkflowVersion: "1.3.0",
+		Status:          workflowAPI.ExecutionStatusRunning,
+		StepId:          "collect",
+	}, nil)
+
+	err := NewMigrateExecutionAction(mockService, "exec-1", map[string]string{"prepare": "collect"}).Run()
+
+	require.NoError(t, err)
+}
+
+func TestMigrateExecutionActionRun_WhenMigrateFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().Migrate(humanActor(), workflowAPI.ExecutionId("exec-1"), map[string]string(nil)).Return(nil, workflowAPI.ErrExecutionUpToDate)
+
+	err := NewMigrateExecutionAction(mockService, "exec-1", nil).Run()
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, workflowAPI.ErrExecutionUpToDate))
+	assert.Contains(t, err.Error(), "migrate execution")
+}
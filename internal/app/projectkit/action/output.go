@@ -0,0 +1,54 @@
+package action
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// writeJSON prints the value as indented JSON, for scripts consuming the output of a command.
+func writeJSON(output io.Writer, value any) error {
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(value)
+}
+
+// writeTable prints the rows aligned in columns under the header. Without a header only the rows are printed.
+func writeTable(output io.Writer, header []string, rows [][]string) error {
+	var table bytes.Buffer
+	writer := tabwriter.NewWriter(&table, 0, 0, 2, ' ', 0)
+	if len(header) > 0 {
+		_, _ = fmt.Fprintln(writer, strings.Join(header, "\t"))
+	}
+	for _, row := range rows {
+		_, _ = fmt.Fprintln(writer, strings.Join(row, "\t"))
+	}
+	_ = writer.Flush()
+
+	// Empty cells are padded as well, so the padding is trimmed from line ends.
+	for _, line := range strings.SplitAfter(table.String(), "\n") {
+		if line == "" {
+			continue
+		}
+
+		if _, err := io.WriteString(output, strings.TrimRight(line, " \n")+"\n"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// formatValue prints a state value the way it is stored.
+func formatValue(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(data)
+}
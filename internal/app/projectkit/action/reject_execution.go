@@ -0,0 +1,38 @@
+package action
+
+import (
+	"fmt"
+	"log/slog"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+type RejectExecutionAction struct {
+	workflowService workflowAPI.Service
+	executionId     workflowAPI.ExecutionId
+	reason          string
+}
+
+func NewRejectExecutionAction(workflowService workflowAPI.Service, executionId workflowAPI.ExecutionId, reason string) *RejectExecutionAction {
+	return &RejectExecutionAction{
+		workflowService: workflowService,
+		executionId:     executionId,
+		reason:          reason,
+	}
+}
+
+func (action *RejectExecutionAction) Run() error {
+	execution, err := action.workflowService.Reject(humanActor(), action.executionId, action.reason)
+	if err != nil {
+		return fmt.Errorf("reject execution: %w", err)
+	}
+
+	slog.Info("Workflow execution rejected.",
+		slog.String("executionId", string(execution.Id)),
+		slog.String("workflowId", string(execution.WorkflowId)),
+		slog.String("stepId", execution.StepId),
+		slog.String("status", string(execution.Status)),
+	)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package action
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+func TestRejectExecutionActionRun_WhenRejected_ThenPassesReason(t *testing.T) {
+	t.Parallel()
+
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().Reject(humanActor(), workflowAPI.ExecutionId("exec-1"), "Migration drops a column.").Return(&workflowAPI.Execution{
+		Id:         "exec-1",
+		WorkflowId: "release",
+		Status:     workflowAPI.ExecutionStatusRunning,
+		StepId:     "prepare",
+		Rejection:  &workflowAPI.Rejection{StepId: "migrate", Reason: "Migration drops a column."},
+	}, nil)
+
+	err := NewRejectExecutionAction(mockService, "exec-1", "Migration drops a column.").Run()
+
+	require.NoError(t, err)
+}
+
+func TestRejectExecutionActionRun_WhenRejectFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().Reject(humanActor(), workflowAPI.ExecutionId("exec-1"), "No.").Return(nil, workflowAPI.ErrExecutionFinished)
+
+	err := NewRejectExecutionAction(mockService, "exec-1", "No.").Run()
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, workflowAPI.ErrExecutionFinished))
+	assert.Contains(t, err.Error(), "reject execution")
+}
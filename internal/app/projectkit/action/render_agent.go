@@ -10,6 +10,8 @@ import (
 	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	mcpAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
 	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
 	projectAPI "github.com/orbiqd/orbiqd-projectkit/pkg/project"
 )
 
@@ -20,6 +22,8 @@ type RenderAgentAction struct {
 	skillRepository       skillAPI.Repository
 	instructionRepository instructionAPI.Repository
 	mcpRepository         mcpAPI.Repository
+	toolRepository        toolAPI.Repository
+	workflowRepository    workflowAPI.Repository
 }
 
 func NewRenderAgentAction(
@@ -29,6 +33,8 @@ func NewRenderAgentAction(
 	skillRepository skillAPI.Repository,
 	instructionRepository instructionAPI.Repository,
 	mcpRepository mcpAPI.Repository,
+	toolRepository toolAPI.Repository,
+	workflowRepository workflowAPI.Repository,
 ) *RenderAgentAction {
 	return &RenderAgentAction{
 		gitFs:                 gitFs,
@@ -37,6 +43,8 @@ func NewRenderAgentAction(
 		skillRepository:       skillRepository,
 		instructionRepository: instructionRepository,
 		mcpRepository:         mcpRepository,
+		toolRepository:        toolRepository,
+		workflowRepository:    workflowRepository,
 	}
 }
 
@@ -82,6 +90,26 @@ func (action *RenderAgentAction) Run() error {
 			return fmt.Errorf("render mcp servers: %w", err)
 		}
 
+		tools, err := action.toolRepository.GetAll()
+		if err != nil {
+			return fmt.Errorf("get all tools: %w", err)
+		}
+
+		err = agent.RenderTools(tools)
+		if err != nil {
+			return fmt.Errorf("render tools: %w", err)
+		}
+
+		workflows, err := action.workflowRepository.GetAllWorkflows()
+		if err != nil {
+			return fmt.Errorf("get all workflows: %w", err)
+		}
+
+		err = agent.RenderWorkflows(workflows)
+		if err != nil {
+			return fmt.Errorf("render workflows: %w", err)
+		}
+
 		for _, pattern := range agent.GitIgnorePatterns() {
 			isExcluded, err := git.IsExcluded(action.gitFs, pattern)
 			if err != nil {
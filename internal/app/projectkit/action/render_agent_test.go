@@ -9,6 +9,8 @@ import (
 	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	mcpAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
 	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
 	projectAPI "github.com/orbiqd/orbiqd-projectkit/pkg/project"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
@@ -42,11 +44,13 @@ func TestRenderAgentActionRun_WhenNoAgents_ThenReturnsNil(t *testing.T) {
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockInstructionRepo := instructionAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 
 	gitFs := afero.NewMemMapFs()
 	config := projectAPI.Config{}
 
-	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo)
+	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
 
 	err := action.Run()
 
@@ -60,6 +64,8 @@ func TestRenderAgentActionRun_WhenSingleAgentNoPatterns_ThenRendersSuccessfully(
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockInstructionRepo := instructionAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 
 	_, mockAgent := setupMockAgentChain(t, mockRegistry, "test-agent", []string{})
 
@@ -68,6 +74,10 @@ func TestRenderAgentActionRun_WhenSingleAgentNoPatterns_ThenRendersSuccessfully(
 	mockAgent.EXPECT().RebuildSkills(mockSkillRepo).Return(nil)
 	mockMcpRepo.EXPECT().GetAll().Return([]mcpAPI.MCPServer{}, nil)
 	mockAgent.EXPECT().RenderMCPServers([]mcpAPI.MCPServer{}).Return(nil)
+	mockToolRepo.EXPECT().GetAll().Return([]toolAPI.Tool{}, nil)
+	mockAgent.EXPECT().RenderTools([]toolAPI.Tool{}).Return(nil)
+	mockWorkflowRepo.EXPECT().GetAllWorkflows().Return([]workflowAPI.Workflow{}, nil)
+	mockAgent.EXPECT().RenderWorkflows([]workflowAPI.Workflow{}).Return(nil)
 
 	gitFs := afero.NewMemMapFs()
 	config := projectAPI.Config{
@@ -76,7 +86,7 @@ func TestRenderAgentActionRun_WhenSingleAgentNoPatterns_ThenRendersSuccessfully(
 		},
 	}
 
-	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo)
+	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
 
 	err := action.Run()
 
@@ -90,6 +100,8 @@ func TestRenderAgentActionRun_WhenAgentWithNewPattern_ThenAddsGitExclude(t *test
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockInstructionRepo := instructionAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 
 	_, mockAgent := setupMockAgentChain(t, mockRegistry, "test-agent", []string{".ai"})
 
@@ -98,6 +110,10 @@ func TestRenderAgentActionRun_WhenAgentWithNewPattern_ThenAddsGitExclude(t *test
 	mockAgent.EXPECT().RebuildSkills(mockSkillRepo).Return(nil)
 	mockMcpRepo.EXPECT().GetAll().Return([]mcpAPI.MCPServer{}, nil)
 	mockAgent.EXPECT().RenderMCPServers([]mcpAPI.MCPServer{}).Return(nil)
+	mockToolRepo.EXPECT().GetAll().Return([]toolAPI.Tool{}, nil)
+	mockAgent.EXPECT().RenderTools([]toolAPI.Tool{}).Return(nil)
+	mockWorkflowRepo.EXPECT().GetAllWorkflows().Return([]workflowAPI.Workflow{}, nil)
+	mockAgent.EXPECT().RenderWorkflows([]workflowAPI.Workflow{}).Return(nil)
 
 	gitFs := afero.NewMemMapFs()
 	config := projectAPI.Config{
@@ -106,7 +122,7 @@ func TestRenderAgentActionRun_WhenAgentWithNewPattern_ThenAddsGitExclude(t *test
 		},
 	}
 
-	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo)
+	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
 
 	err := action.Run()
 
@@ -124,6 +140,8 @@ func TestRenderAgentActionRun_WhenAgentWithExistingPattern_ThenSkipsExclude(t *t
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockInstructionRepo := instructionAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 
 	_, mockAgent := setupMockAgentChain(t, mockRegistry, "test-agent", []string{".ai"})
 
@@ -132,6 +150,10 @@ func TestRenderAgentActionRun_WhenAgentWithExistingPattern_ThenSkipsExclude(t *t
 	mockAgent.EXPECT().RebuildSkills(mockSkillRepo).Return(nil)
 	mockMcpRepo.EXPECT().GetAll().Return([]mcpAPI.MCPServer{}, nil)
 	mockAgent.EXPECT().RenderMCPServers([]mcpAPI.MCPServer{}).Return(nil)
+	mockToolRepo.EXPECT().GetAll().Return([]toolAPI.Tool{}, nil)
+	mockAgent.EXPECT().RenderTools([]toolAPI.Tool{}).Return(nil)
+	mockWorkflowRepo.EXPECT().GetAllWorkflows().Return([]workflowAPI.Workflow{}, nil)
+	mockAgent.EXPECT().RenderWorkflows([]workflowAPI.Workflow{}).Return(nil)
 
 	gitFs := afero.NewMemMapFs()
 	require.NoError(t, gitFs.MkdirAll("info", 0755))
@@ -143,7 +165,7 @@ func TestRenderAgentActionRun_WhenAgentWithExistingPattern_ThenSkipsExclude(t *t
 		},
 	}
 
-	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo)
+	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
 
 	err := action.Run()
 
@@ -161,6 +183,8 @@ func TestRenderAgentActionRun_WhenMultipleAgents_ThenRendersAll(t *testing.T) {
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockInstructionRepo := instructionAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 
 	_, mockAgent1 := setupMockAgentChain(t, mockRegistry, "agent-one", []string{})
 	_, mockAgent2 := setupMockAgentChain(t, mockRegistry, "agent-two", []string{})
@@ -172,10 +196,18 @@ func TestRenderAgentActionRun_WhenMultipleAgents_ThenRendersAll(t *testing.T) {
 	mockAgent1.EXPECT().RebuildSkills(mockSkillRepo).Return(nil)
 	mockMcpRepo.EXPECT().GetAll().Return(mcpServers, nil)
 	mockAgent1.EXPECT().RenderMCPServers(mcpServers).Return(nil)
+	mockToolRepo.EXPECT().GetAll().Return([]toolAPI.Tool{}, nil)
+	mockAgent1.EXPECT().RenderTools([]toolAPI.Tool{}).Return(nil)
+	mockWorkflowRepo.EXPECT().GetAllWorkflows().Return([]workflowAPI.Workflow{}, nil)
+	mockAgent1.EXPECT().RenderWorkflows([]workflowAPI.Workflow{}).Return(nil)
 	mockAgent2.EXPECT().RenderInstructions(instructions).Return(nil)
 	mockAgent2.EXPECT().RebuildSkills(mockSkillRepo).Return(nil)
 	mockMcpRepo.EXPECT().GetAll().Return(mcpServers, nil)
 	mockAgent2.EXPECT().RenderMCPServers(mcpServers).Return(nil)
+	mockToolRepo.EXPECT().GetAll().Return([]toolAPI.Tool{}, nil)
+	mockAgent2.EXPECT().RenderTools([]toolAPI.Tool{}).Return(nil)
+	mockWorkflowRepo.EXPECT().GetAllWorkflows().Return([]workflowAPI.Workflow{}, nil)
+	mockAgent2.EXPECT().RenderWorkflows([]workflowAPI.Workflow{}).Return(nil)
 
 	gitFs := afero.NewMemMapFs()
 	config := projectAPI.Config{
@@ -185,7 +217,7 @@ func TestRenderAgentActionRun_WhenMultipleAgents_ThenRendersAll(t *testing.T) {
 		},
 	}
 
-	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo)
+	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
 
 	err := action.Run()
 
@@ -199,6 +231,8 @@ func TestRenderAgentActionRun_WhenLoadAgentsFails_ThenReturnsError(t *testing.T)
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockInstructionRepo := instructionAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 
 	loadErr := errors.New("load agents error")
 	mockRegistry.EXPECT().GetByKind(agentAPI.Kind("test-agent")).Return(nil, loadErr)
@@ -210,7 +244,7 @@ func TestRenderAgentActionRun_WhenLoadAgentsFails_ThenReturnsError(t *testing.T)
 		},
 	}
 
-	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo)
+	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
 
 	err := action.Run()
 
@@ -226,6 +260,8 @@ func TestRenderAgentActionRun_WhenGetAllInstructionsFails_ThenReturnsError(t *te
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockInstructionRepo := instructionAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 
 	mockProvider := agentAPI.NewMockProvider(t)
 	mockAgent := agentAPI.NewMockAgent(t)
@@ -244,7 +280,7 @@ func TestRenderAgentActionRun_WhenGetAllInstructionsFails_ThenReturnsError(t *te
 		},
 	}
 
-	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo)
+	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
 
 	err := action.Run()
 
@@ -260,6 +296,8 @@ func TestRenderAgentActionRun_WhenRenderInstructionsFails_ThenReturnsError(t *te
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockInstructionRepo := instructionAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 
 	mockProvider := agentAPI.NewMockProvider(t)
 	mockAgent := agentAPI.NewMockAgent(t)
@@ -280,7 +318,7 @@ func TestRenderAgentActionRun_WhenRenderInstructionsFails_ThenReturnsError(t *te
 		},
 	}
 
-	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo)
+	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
 
 	err := action.Run()
 
@@ -296,6 +334,8 @@ func TestRenderAgentActionRun_WhenRebuildSkillsFails_ThenReturnsError(t *testing
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockInstructionRepo := instructionAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 
 	mockProvider := agentAPI.NewMockProvider(t)
 	mockAgent := agentAPI.NewMockAgent(t)
@@ -317,7 +357,7 @@ func TestRenderAgentActionRun_WhenRebuildSkillsFails_ThenReturnsError(t *testing
 		},
 	}
 
-	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo)
+	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
 
 	err := action.Run()
 
@@ -333,6 +373,8 @@ func TestRenderAgentActionRun_WhenIsExcludedFails_ThenReturnsError(t *testing.T)
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockInstructionRepo := instructionAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 
 	_, mockAgent := setupMockAgentChain(t, mockRegistry, "test-agent", []string{".ai"})
 
@@ -342,6 +384,10 @@ func TestRenderAgentActionRun_WhenIsExcludedFails_ThenReturnsError(t *testing.T)
 	mockAgent.EXPECT().RebuildSkills(mockSkillRepo).Return(nil)
 	mockMcpRepo.EXPECT().GetAll().Return([]mcpAPI.MCPServer{}, nil)
 	mockAgent.EXPECT().RenderMCPServers([]mcpAPI.MCPServer{}).Return(nil)
+	mockToolRepo.EXPECT().GetAll().Return([]toolAPI.Tool{}, nil)
+	mockAgent.EXPECT().RenderTools([]toolAPI.Tool{}).Return(nil)
+	mockWorkflowRepo.EXPECT().GetAllWorkflows().Return([]workflowAPI.Workflow{}, nil)
+	mockAgent.EXPECT().RenderWorkflows([]workflowAPI.Workflow{}).Return(nil)
 
 	statErr := errors.New("stat error")
 	baseFs := afero.NewMemMapFs()
@@ -357,7 +403,7 @@ func TestRenderAgentActionRun_WhenIsExcludedFails_ThenReturnsError(t *testing.T)
 		},
 	}
 
-	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo)
+	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
 
 	err := action.Run()
 
@@ -372,6 +418,8 @@ func TestRenderAgentActionRun_WhenExcludeFails_ThenReturnsError(t *testing.T) {
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockInstructionRepo := instructionAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 
 	_, mockAgent := setupMockAgentChain(t, mockRegistry, "test-agent", []string{".ai"})
 
@@ -381,6 +429,10 @@ func TestRenderAgentActionRun_WhenExcludeFails_ThenReturnsError(t *testing.T) {
 	mockAgent.EXPECT().RebuildSkills(mockSkillRepo).Return(nil)
 	mockMcpRepo.EXPECT().GetAll().Return([]mcpAPI.MCPServer{}, nil)
 	mockAgent.EXPECT().RenderMCPServers([]mcpAPI.MCPServer{}).Return(nil)
+	mockToolRepo.EXPECT().GetAll().Return([]toolAPI.Tool{}, nil)
+	mockAgent.EXPECT().RenderTools([]toolAPI.Tool{}).Return(nil)
+	mockWorkflowRepo.EXPECT().GetAllWorkflows().Return([]workflowAPI.Workflow{}, nil)
+	mockAgent.EXPECT().RenderWorkflows([]workflowAPI.Workflow{}).Return(nil)
 
 	mkdirErr := errors.New("mkdir error")
 	baseFs := afero.NewMemMapFs()
@@ -396,7 +448,7 @@ func TestRenderAgentActionRun_WhenExcludeFails_ThenReturnsError(t *testing.T) {
 		},
 	}
 
-	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo)
+	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
 
 	err := action.Run()
 
@@ -411,6 +463,8 @@ func TestRenderAgentActionRun_WhenGetAllMCPServersFails_ThenReturnsError(t *test
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockInstructionRepo := instructionAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 
 	mockProvider := agentAPI.NewMockProvider(t)
 	mockAgent := agentAPI.NewMockAgent(t)
@@ -433,7 +487,7 @@ func TestRenderAgentActionRun_WhenGetAllMCPServersFails_ThenReturnsError(t *test
 		},
 	}
 
-	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo)
+	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
 
 	err := action.Run()
 
@@ -449,6 +503,8 @@ func TestRenderAgentActionRun_WhenRenderMCPServersFails_ThenReturnsError(t *test
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockInstructionRepo := instructionAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 
 	mockProvider := agentAPI.NewMockProvider(t)
 	mockAgent := agentAPI.NewMockAgent(t)
@@ -473,7 +529,7 @@ func TestRenderAgentActionRun_WhenRenderMCPServersFails_ThenReturnsError(t *test
 		},
 	}
 
-	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo)
+	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
 
 	err := action.Run()
 
@@ -481,3 +537,182 @@ func TestRenderAgentActionRun_WhenRenderMCPServersFails_ThenReturnsError(t *test
 	assert.Contains(t, err.Error(), "render mcp servers")
 	assert.ErrorIs(t, err, renderErr)
 }
+
+func TestRenderAgentActionRun_WhenGetAllToolsFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockRegistry := agentAPI.NewMockRegistry(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockInstructionRepo := instructionAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+
+	mockProvider := agentAPI.NewMockProvider(t)
+	mockAgent := agentAPI.NewMockAgent(t)
+
+	mockRegistry.EXPECT().GetByKind(agentAPI.Kind("test-agent")).Return(mockProvider, nil)
+	mockProvider.EXPECT().NewAgent(nil).Return(mockAgent, nil)
+	mockAgent.EXPECT().GetKind().Return(agentAPI.Kind("test-agent"))
+
+	getAllErr := errors.New("get all tools error")
+	mockInstructionRepo.EXPECT().GetAll().Return([]instructionAPI.Instructions{}, nil)
+	mockAgent.EXPECT().RenderInstructions([]instructionAPI.Instructions{}).Return(nil)
+	mockAgent.EXPECT().RebuildSkills(mockSkillRepo).Return(nil)
+	mockMcpRepo.EXPECT().GetAll().Return([]mcpAPI.MCPServer{}, nil)
+	mockAgent.EXPECT().RenderMCPServers([]mcpAPI.MCPServer{}).Return(nil)
+	mockToolRepo.EXPECT().GetAll().Return(nil, getAllErr)
+
+	gitFs := afero.NewMemMapFs()
+	config := projectAPI.Config{
+		Agents: []agentAPI.Config{
+			{Kind: "test-agent"},
+		},
+	}
+
+	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
+
+	err := action.Run()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "get all tools")
+	assert.ErrorIs(t, err, getAllErr)
+}
+
+func TestRenderAgentActionRun_WhenRenderToolsFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockRegistry := agentAPI.NewMockRegistry(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockInstructionRepo := instructionAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+
+	mockProvider := agentAPI.NewMockProvider(t)
+	mockAgent := agentAPI.NewMockAgent(t)
+
+	mockRegistry.EXPECT().GetByKind(agentAPI.Kind("test-agent")).Return(mockProvider, nil)
+	mockProvider.EXPECT().NewAgent(nil).Return(mockAgent, nil)
+	mockAgent.EXPECT().GetKind().Return(agentAPI.Kind("test-agent"))
+
+	renderErr := errors.New("render tools error")
+	tools := []toolAPI.Tool{
+		{
+			Metadata:   toolAPI.Metadata{ID: "go-test", Name: "Go Test", Description: "Runs go tests."},
+			Definition: toolAPI.Definition{Commands: []toolAPI.Command{"go test"}},
+		},
+	}
+	mockInstructionRepo.EXPECT().GetAll().Return([]instructionAPI.Instructions{}, nil)
+	mockAgent.EXPECT().RenderInstructions([]instructionAPI.Instructions{}).Return(nil)
+	mockAgent.EXPECT().RebuildSkills(mockSkillRepo).Return(nil)
+	mockMcpRepo.EXPECT().GetAll().Return([]mcpAPI.MCPServer{}, nil)
+	mockAgent.EXPECT().RenderMCPServers([]mcpAPI.MCPServer{}).Return(nil)
+	mockToolRepo.EXPECT().GetAll().Return(tools, nil)
+	mockAgent.EXPECT().RenderTools(tools).Return(renderErr)
+
+	gitFs := afero.NewMemMapFs()
+	config := projectAPI.Config{
+		Agents: []agentAPI.Config{
+			{Kind: "test-agent"},
+		},
+	}
+
+	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
+
+	err := action.Run()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "render tools")
+	assert.ErrorIs(t, err, renderErr)
+}
+
+func TestRenderAgentActionRun_WhenGetAllWorkflowsFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockRegistry := agentAPI.NewMockRegistry(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockInstructionRepo := instructionAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+
+	mockProvider := agentAPI.NewMockProvider(t)
+	mockAgent := agentAPI.NewMockAgent(t)
+
+	mockRegistry.EXPECT().GetByKind(agentAPI.Kind("test-agent")).Return(mockProvider, nil)
+	mockProvider.EXPECT().NewAgent(nil).Return(mockAgent, nil)
+	mockAgent.EXPECT().GetKind().Return(agentAPI.Kind("test-agent"))
+
+	repoErr := errors.New("workflow repository error")
+	mockInstructionRepo.EXPECT().GetAll().Return([]instructionAPI.Instructions{}, nil)
+	mockAgent.EXPECT().RenderInstructions([]instructionAPI.Instructions{}).Return(nil)
+	mockAgent.EXPECT().RebuildSkills(mockSkillRepo).Return(nil)
+	mockMcpRepo.EXPECT().GetAll().Return([]mcpAPI.MCPServer{}, nil)
+	mockAgent.EXPECT().RenderMCPServers([]mcpAPI.MCPServer{}).Return(nil)
+	mockToolRepo.EXPECT().GetAll().Return([]toolAPI.Tool{}, nil)
+	mockAgent.EXPECT().RenderTools([]toolAPI.Tool{}).Return(nil)
+	mockWorkflowRepo.EXPECT().GetAllWorkflows().Return(nil, repoErr)
+
+	gitFs := afero.NewMemMapFs()
+	config := projectAPI.Config{
+		Agents: []agentAPI.Config{
+			{Kind: "test-agent"},
+		},
+	}
+
+	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
+
+	err := action.Run()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "get all workflows")
+	assert.ErrorIs(t, err, repoErr)
+}
+
+func TestRenderAgentActionRun_WhenRenderWorkflowsFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockRegistry := agentAPI.NewMockRegistry(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockInstructionRepo := instructionAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+
+	mockProvider := agentAPI.NewMockProvider(t)
+	mockAgent := agentAPI.NewMockAgent(t)
+
+	mockRegistry.EXPECT().GetByKind(agentAPI.Kind("test-agent")).Return(mockProvider, nil)
+	mockProvider.EXPECT().NewAgent(nil).Return(mockAgent, nil)
+	mockAgent.EXPECT().GetKind().Return(agentAPI.Kind("test-agent"))
+
+	renderErr := errors.New("render workflows error")
+	workflows := []workflowAPI.Workflow{
+		{Metadata: workflowAPI.Metadata{ID: "example", Name: "Example", Description: "Example workflow.", Version: "0.1.0"}},
+	}
+	mockInstructionRepo.EXPECT().GetAll().Return([]instructionAPI.Instructions{}, nil)
+	mockAgent.EXPECT().RenderInstructions([]instructionAPI.Instructions{}).Return(nil)
+	mockAgent.EXPECT().RebuildSkills(mockSkillRepo).Return(nil)
+	mockMcpRepo.EXPECT().GetAll().Return([]mcpAPI.MCPServer{}, nil)
+	mockAgent.EXPECT().RenderMCPServers([]mcpAPI.MCPServer{}).Return(nil)
+	mockToolRepo.EXPECT().GetAll().Return([]toolAPI.Tool{}, nil)
+	mockAgent.EXPECT().RenderTools([]toolAPI.Tool{}).Return(nil)
+	mockWorkflowRepo.EXPECT().GetAllWorkflows().Return(workflows, nil)
+	mockAgent.EXPECT().RenderWorkflows(workflows).Return(renderErr)
+
+	gitFs := afero.NewMemMapFs()
+	config := projectAPI.Config{
+		Agents: []agentAPI.Config{
+			{Kind: "test-agent"},
+		},
+	}
+
+	action := NewRenderAgentAction(gitFs, config, mockRegistry, mockSkillRepo, mockInstructionRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
+
+	err := action.Run()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "render workflows")
+	assert.ErrorIs(t, err, renderErr)
+}
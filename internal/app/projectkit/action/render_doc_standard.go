@@ -59,7 +59,7 @@ func (action *RenderDocStandardAction) renderToDestination(
 		return err
 	}
 
-	if err := action.cleanDestination(renderConfig, renderer.FileExtension()); err != nil {
+	if err := cleanRenderDestination(action.projectFs, renderConfig.Destination, renderer.FileExtension()); err != nil {
 		return fmt.Errorf("failed to clean destination: %w", err)
 	}
 
@@ -95,17 +95,19 @@ func (action *RenderDocStandardAction) createRenderer(renderConfig standardAPI.R
 	return r, nil
 }
 
-func (action *RenderDocStandardAction) cleanDestination(renderConfig standardAPI.RenderConfig, fileExtension string) error {
-	exists, err := afero.DirExists(action.projectFs, renderConfig.Destination)
+// cleanRenderDestination creates the destination directory, or removes the files with the given extension that
+// an earlier render left in it.
+func cleanRenderDestination(projectFs projectAPI.Fs, destination string, fileExtension string) error {
+	exists, err := afero.DirExists(projectFs, destination)
 	if err != nil {
 		return err
 	}
 
 	if !exists {
-		return action.projectFs.MkdirAll(renderConfig.Destination, 0755)
+		return projectFs.MkdirAll(destination, 0755)
 	}
 
-	files, err := afero.ReadDir(action.projectFs, renderConfig.Destination)
+	files, err := afero.ReadDir(projectFs, destination)
 	if err != nil {
 		return err
 	}
@@ -116,8 +118,8 @@ func (action *RenderDocStandardAction) cleanDestination(renderConfig standardAPI
 		}
 
 		if filepath.Ext(file.Name()) == fileExtension {
-			filePath := filepath.Join(renderConfig.Destination, file.Name())
-			if err := action.projectFs.Remove(filePath); err != nil {
+			filePath := filepath.Join(destination, file.Name())
+			if err := projectFs.Remove(filePath); err != nil {
 				return fmt.Errorf("failed to remove file %s: %w", filePath, err)
 			}
 		}
@@ -0,0 +1,84 @@
+package action
+
+import (
+	"fmt"
+	"log/slog"
+	"path/filepath"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+	projectAPI "github.com/orbiqd/orbiqd-projectkit/pkg/project"
+	"github.com/spf13/afero"
+)
+
+type RenderDocWorkflowAction struct {
+	workflowRepository workflowAPI.Repository
+	projectFs          projectAPI.Fs
+	renderConfigs      []workflowAPI.RenderConfig
+	renderers          map[string]workflowAPI.Renderer
+}
+
+func NewRenderDocWorkflowAction(
+	workflowRepository workflowAPI.Repository,
+	projectFs projectAPI.Fs,
+	renderConfigs []workflowAPI.RenderConfig,
+	renderers map[string]workflowAPI.Renderer,
+) *RenderDocWorkflowAction {
+	return &RenderDocWorkflowAction{
+		workflowRepository: workflowRepository,
+		projectFs:          projectFs,
+		renderConfigs:      renderConfigs,
+		renderers:          renderers,
+	}
+}
+
+func (action *RenderDocWorkflowAction) Run() error {
+	workflows, err := action.workflowRepository.GetAllWorkflows()
+	if err != nil {
+		return fmt.Errorf("failed to get workflows: %w", err)
+	}
+	slog.Info("Loaded workflows from repository.", slog.Int("count", len(workflows)))
+
+	for _, renderConfig := range action.renderConfigs {
+		if err := action.renderToDestination(renderConfig, workflows); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (action *RenderDocWorkflowAction) renderToDestination(
+	renderConfig workflowAPI.RenderConfig,
+	workflows []workflowAPI.Workflow,
+) error {
+	renderer, ok := action.renderers[renderConfig.Format]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnsupportedFormat, renderConfig.Format)
+	}
+
+	if err := cleanRenderDestination(action.projectFs, renderConfig.Destination, renderer.FileExtension()); err != nil {
+		return fmt.Errorf("failed to clean destination: %w", err)
+	}
+
+	for _, workflow := range workflows {
+		rendered, err := renderer.Render(workflow)
+		if err != nil {
+			return fmt.Errorf("failed to render workflow %s: %w", workflow.Metadata.ID, err)
+		}
+
+		filePath := filepath.Join(renderConfig.Destination, string(workflow.Metadata.ID)+renderer.FileExtension())
+
+		if err := afero.WriteFile(action.projectFs, filePath, rendered, 0644); err != nil {
+			return fmt.Errorf("failed to write file %s: %w", filePath, err)
+		}
+
+		slog.Debug("Rendered workflow to destination.", slog.String("filePath", filePath))
+	}
+
+	slog.Info("Rendered workflows to destination.",
+		slog.Int("count", len(workflows)),
+		slog.String("destination", renderConfig.Destination),
+	)
+
+	return nil
+}
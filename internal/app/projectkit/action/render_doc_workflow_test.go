@@ -0,0 +1,127 @@
+package action
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/orbiqd/orbiqd-projectkit/internal/pkg/ai/workflow"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func workflowRenderers() map[string]workflowAPI.Renderer {
+	return map[string]workflowAPI.Renderer{
+		"markdown": workflow.NewMarkdownRenderer(),
+		"mermaid":  workflow.NewMermaidRenderer(),
+	}
+}
+
+func TestRenderDocWorkflowActionRun_WhenMarkdownAndMermaid_ThenWritesFilesPerWorkflow(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := workflowAPI.NewMockRepository(t)
+	mockRepo.EXPECT().GetAllWorkflows().Return([]workflowAPI.Workflow{actionTestWorkflow()}, nil)
+
+	projectFs := afero.NewMemMapFs()
+	renderConfigs := []workflowAPI.RenderConfig{
+		{Format: "markdown", Destination: "/docs/workflows"},
+		{Format: "mermaid", Destination: "/docs/workflows"},
+	}
+	action := NewRenderDocWorkflowAction(mockRepo, projectFs, renderConfigs, workflowRenderers())
+
+	err := action.Run()
+
+	require.NoError(t, err)
+
+	markdown, err := afero.ReadFile(projectFs, "/docs/workflows/release.md")
+	require.NoError(t, err)
+	assert.Contains(t, string(markdown), "```mermaid\nflowchart TD\n")
+
+	mermaid, err := afero.ReadFile(projectFs, "/docs/workflows/release.mmd")
+	require.NoError(t, err)
+	assert.Contains(t, string(mermaid), "flowchart TD\n")
+}
+
+func TestRenderDocWorkflowActionRun_WhenDestinationHasStaleFiles_ThenRemovesOnlyMatchingExtension(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := workflowAPI.NewMockRepository(t)
+	mockRepo.EXPECT().GetAllWorkflows().Return([]workflowAPI.Workflow{}, nil)
+
+	projectFs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(projectFs, "/docs/removed.md", []byte("stale"), 0644))
+	require.NoError(t, afero.WriteFile(projectFs, "/docs/removed.mmd", []byte("kept"), 0644))
+
+	renderConfigs := []workflowAPI.RenderConfig{
+		{Format: "markdown", Destination: "/docs"},
+	}
+	action := NewRenderDocWorkflowAction(mockRepo, projectFs, renderConfigs, workflowRenderers())
+
+	err := action.Run()
+
+	require.NoError(t, err)
+
+	exists, _ := afero.Exists(projectFs, "/docs/removed.md")
+	assert.False(t, exists)
+
+	exists, _ = afero.Exists(projectFs, "/docs/removed.mmd")
+	assert.True(t, exists)
+}
+
+func TestRenderDocWorkflowActionRun_WhenRepositoryFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	repoErr := errors.New("repository error")
+	mockRepo := workflowAPI.NewMockRepository(t)
+	mockRepo.EXPECT().GetAllWorkflows().Return(nil, repoErr)
+
+	action := NewRenderDocWorkflowAction(mockRepo, afero.NewMemMapFs(), nil, workflowRenderers())
+
+	err := action.Run()
+
+	require.ErrorIs(t, err, repoErr)
+}
+
+func TestRenderDocWorkflowActionRun_WhenUnsupportedFormat_ThenReturnsUnsupportedFormatError(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := workflowAPI.NewMockRepository(t)
+	mockRepo.EXPECT().GetAllWorkflows().Return([]workflowAPI.Workflow{actionTestWorkflow()}, nil)
+
+	renderConfigs := []workflowAPI.RenderConfig{
+		{Format: "html", Destination: "/docs"},
+	}
+	action := NewRenderDocWorkflowAction(mockRepo, afero.NewMemMapFs(), renderConfigs, workflowRenderers())
+
+	err := action.Run()
+
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestRenderDocWorkflowActionRun_WhenRendererFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	workflow := actionTestWorkflow()
+	mockRepo := workflowAPI.NewMockRepository(t)
+	mockRepo.EXPECT().GetAllWorkflows().Return([]workflowAPI.Workflow{workflow}, nil)
+
+	renderErr := errors.New("render error")
+	mockRenderer := workflowAPI.NewMockRenderer(t)
+	mockRenderer.EXPECT().FileExtension().Return(".md")
+	mockRenderer.EXPECT().Render(workflow).Return(nil, renderErr)
+
+	renderConfigs := []workflowAPI.RenderConfig{
+		{Format: "markdown", Destination: "/docs"},
+	}
+	renderers := map[string]workflowAPI.Renderer{
+		"markdown": mockRenderer,
+	}
+	action := NewRenderDocWorkflowAction(mockRepo, afero.NewMemMapFs(), renderConfigs, renderers)
+
+	err := action.Run()
+
+	require.ErrorIs(t, err, renderErr)
+	assert.Contains(t, err.Error(), "failed to render workflow")
+}
@@ -0,0 +1,114 @@
+package action
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+// executionStatus is an execution together with the step it is at.
+type executionStatus struct {
+	workflowAPI.Execution
+
+	StepNumber int              `json:"stepNumber"`
+	StepsCount int              `json:"stepsCount"`
+	Step       workflowAPI.Step `json:"step"`
+}
+
+type ShowExecutionAction struct {
+	workflowRepository workflowAPI.Repository
+	workflowService    workflowAPI.Service
+	executionId        workflowAPI.ExecutionId
+	output             io.Writer
+	json               bool
+}
+
+func NewShowExecutionAction(workflowRepository workflowAPI.Repository, workflowService workflowAPI.Service, executionId workflowAPI.ExecutionId, output io.Writer, json bool) *ShowExecutionAction {
+	return &ShowExecutionAction{
+		workflowRepository: workflowRepository,
+		workflowService:    workflowService,
+		executionId:        executionId,
+		output:             output,
+		json:               json,
+	}
+}
+
+func (action *ShowExecutionAction) Run() error {
+	execution, err := action.workflowService.GetExecution(action.executionId)
+	if err != nil {
+		return fmt.Errorf("show execution: %w", err)
+	}
+
+	if err := writeExecutionStatus(action.output, action.workflowRepository, *execution, action.json); err != nil {
+		return fmt.Errorf("show execution: %w", err)
+	}
+
+	return nil
+}
+
+func writeExecutionStatus(output io.Writer, workflowRepository workflowAPI.Repository, execution workflowAPI.Execution, json bool) error {
+	workflow, err := workflowRepository.GetWorkflowVersion(execution.WorkflowId, execution.WorkflowVersion)
+	if err != nil {
+		return fmt.Errorf("get workflow %s: %w", execution.WorkflowId, err)
+	}
+
+	index, found := workflow.StepIndex(execution.StepId)
+	if !found {
+		return fmt.Errorf("execution %s step %s: %w", execution.Id, execution.StepId, workflowAPI.ErrStepNotFound)
+	}
+
+	status := executionStatus{
+		Execution:  execution,
+		StepNumber: index + 1,
+		StepsCount: len(workflow.Steps),
+		Step:       workflow.Steps[index],
+	}
+
+	if json {
+		return writeJSON(output, status)
+	}
+
+	details := [][]string{
+		{"Execution:", string(execution.Id)},
+		{"Workflow:", fmt.Sprintf("%s (%s)", workflow.Metadata.ID, workflow.Metadata.Name)},
+		{"Version:", workflow.Metadata.Version},
+		{"Status:", string(execution.Status)},
+		{"Step:", fmt.Sprintf("%d/%d %s (%s)", status.StepNumber, status.StepsCount, status.Step.ID, status.Step.Name)},
+	}
+	if execution.Status == workflowAPI.ExecutionStatusAwaitingApproval && status.Step.Approval != nil {
+		details = append(details, []string{"Approval:", status.Step.Approval.Message})
+	}
+	if execution.Rejection != nil {
+		details = append(details, []string{"Rejected:", fmt.Sprintf("%s: %s", execution.Rejection.StepId, execution.Rejection.Reason)})
+	}
+	if execution.ChildExecutionId != "" {
+		details = append(details, []string{"Waiting for:", string(execution.ChildExecutionId)})
+	}
+	if execution.ParentExecutionId != "" {
+		details = append(details, []string{"Parent:", string(execution.ParentExecutionId)})
+	}
+
+	if err := writeTable(output, nil, details); err != nil {
+		return err
+	}
+
+	if len(execution.StateValues) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(execution.StateValues))
+	for key := range execution.StateValues {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	rows := make([][]string, 0, len(keys))
+	for _, key := range keys {
+		rows = append(rows, []string{key, formatValue(execution.StateValues[key])})
+	}
+
+	_, _ = fmt.Fprintln(output)
+	return writeTable(output, []string{"STATE", "VALUE"}, rows)
+}
@@ -0,0 +1,74 @@
+package action
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+type ShowExecutionHistoryAction struct {
+	workflowService workflowAPI.Service
+	executionId     workflowAPI.ExecutionId
+	output          io.Writer
+	json            bool
+}
+
+func NewShowExecutionHistoryAction(workflowService workflowAPI.Service, executionId workflowAPI.ExecutionId, output io.Writer, json bool) *ShowExecutionHistoryAction {
+	return &ShowExecutionHistoryAction{
+		workflowService: workflowService,
+		executionId:     executionId,
+		output:          output,
+		json:            json,
+	}
+}
+
+func (action *ShowExecutionHistoryAction) Run() error {
+	events, err := action.workflowService.History(action.executionId)
+	if err != nil {
+		return fmt.Errorf("show execution history: %w", err)
+	}
+
+	if action.json {
+		err = writeJSON(action.output, events)
+	} else {
+		err = writeTable(action.output, []string{"TIME", "ACTOR", "EVENT", "STEP", "DETAILS"}, eventRows(events))
+	}
+	if err != nil {
+		return fmt.Errorf("show execution history: %w", err)
+	}
+
+	return nil
+}
+
+func eventRows(events []workflowAPI.Event) [][]string {
+	rows := make([][]string, 0, len(events))
+	for _, event := range events {
+		rows = append(rows, []string{
+			event.Time.Local().Format(time.DateTime),
+			event.Actor.String(),
+			string(event.Type),
+			event.StepId,
+			eventDetails(event),
+		})
+	}
+
+	return rows
+}
+
+func eventDetails(event workflowAPI.Event) string {
+	switch event.Type {
+	case workflowAPI.EventTypeStateChanged:
+		return fmt.Sprintf("%s: %s -> %s", event.Key, formatValue(event.OldValue), formatValue(event.NewValue))
+	case workflowAPI.EventTypeRejected:
+		return event.Reason
+	case workflowAPI.EventTypeStarted, workflowAPI.EventTypeMigrated:
+		if event.WorkflowVersion == "" {
+			return ""
+		}
+		return "version " + event.WorkflowVersion
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,54 @@
+package action
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+func TestShowExecutionHistoryActionRun_WhenEventsRecorded_ThenPrintsTable(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2025, 3, 14, 9, 30, 0, 0, time.Local)
+	agent := workflowAPI.Actor{Kind: workflowAPI.ActorKindAgent, Name: "claude-code"}
+	human := workflowAPI.Actor{Kind: workflowAPI.ActorKindHuman, Name: "jane"}
+
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().History(workflowAPI.ExecutionId("exec-1")).Return([]workflowAPI.Event{
+		{Time: startedAt, Type: workflowAPI.EventTypeStarted, Actor: agent, WorkflowVersion: "1.2.0"},
+		{Time: startedAt, Type: workflowAPI.EventTypeStateChanged, Actor: agent, StepId: "prepare", Key: "firstNumber", NewValue: float64(7)},
+		{Time: startedAt.Add(time.Minute), Type: workflowAPI.EventTypeRejected, Actor: human, StepId: "publish", Reason: "Too risky."},
+		{Time: startedAt.Add(2 * time.Minute), Type: workflowAPI.EventTypeMigrated, Actor: human, StepId: "prepare", WorkflowVersion: "1.3.0"},
+	}, nil)
+
+	var output bytes.Buffer
+	err := NewShowExecutionHistoryAction(mockService, "exec-1", &output, false).Run()
+
+	require.NoError(t, err)
+	assert.Equal(t, ""+
+		"TIME                 ACTOR              EVENT          STEP     DETAILS\n"+
+		"2025-03-14 09:30:00  agent:claude-code  started                 version 1.2.0\n"+
+		"2025-03-14 09:30:00  agent:claude-code  state-changed  prepare  firstNumber: null -> 7\n"+
+		"2025-03-14 09:31:00  human:jane         rejected       publish  Too risky.\n"+
+		"2025-03-14 09:32:00  human:jane         migrated       prepare  version 1.3.0\n",
+		output.String())
+}
+
+func TestShowExecutionHistoryActionRun_WhenHistoryFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().History(workflowAPI.ExecutionId("exec-1")).Return(nil, workflowAPI.ErrExecutionNotFound)
+
+	err := NewShowExecutionHistoryAction(mockService, "exec-1", &bytes.Buffer{}, false).Run()
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, workflowAPI.ErrExecutionNotFound))
+	assert.Contains(t, err.Error(), "show execution history")
+}
@@ -0,0 +1,118 @@
+package action
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+func TestShowExecutionActionRun_WhenAwaitingApproval_ThenPrintsStepAndState(t *testing.T) {
+	t.Parallel()
+
+	workflow := actionTestWorkflow()
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetWorkflowVersion(workflowAPI.WorkflowId("release"), "1.2.0").Return(&workflow, nil)
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().GetExecution(workflowAPI.ExecutionId("exec-1")).Return(&workflowAPI.Execution{
+		Id:              "exec-1",
+		WorkflowId:      "release",
+		WorkflowVersion: "1.2.0",
+		Status:          workflowAPI.ExecutionStatusAwaitingApproval,
+		StateValues:     map[string]any{"version": "1.3.0"},
+		StepId:          "publish",
+	}, nil)
+
+	var output bytes.Buffer
+	err := NewShowExecutionAction(mockRepository, mockService, "exec-1", &output, false).Run()
+
+	require.NoError(t, err)
+	assert.Equal(t, ""+
+		"Execution:  exec-1\n"+
+		"Workflow:   release (Release)\n"+
+		"Version:    1.2.0\n"+
+		"Status:     awaiting-approval\n"+
+		"Step:       2/2 publish (Publish)\n"+
+		"Approval:   Check the changelog.\n"+
+		"\n"+
+		"STATE    VALUE\n"+
+		"version  \"1.3.0\"\n",
+		output.String())
+}
+
+func TestShowExecutionActionRun_WhenSuspended_ThenPrintsChildExecution(t *testing.T) {
+	t.Parallel()
+
+	workflow := actionTestWorkflow()
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetWorkflowVersion(workflowAPI.WorkflowId("release"), "1.2.0").Return(&workflow, nil)
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().GetExecution(workflowAPI.ExecutionId("exec-1")).Return(&workflowAPI.Execution{
+		Id:                "exec-1",
+		WorkflowId:        "release",
+		WorkflowVersion:   "1.2.0",
+		Status:            workflowAPI.ExecutionStatusSuspended,
+		StepId:            "prepare",
+		ParentExecutionId: "exec-0",
+		ChildExecutionId:  "exec-2",
+	}, nil)
+
+	var output bytes.Buffer
+	err := NewShowExecutionAction(mockRepository, mockService, "exec-1", &output, false).Run()
+
+	require.NoError(t, err)
+	assert.Equal(t, ""+
+		"Execution:    exec-1\n"+
+		"Workflow:     release (Release)\n"+
+		"Version:      1.2.0\n"+
+		"Status:       suspended\n"+
+		"Step:         1/2 prepare (Prepare)\n"+
+		"Waiting for:  exec-2\n"+
+		"Parent:       exec-0\n",
+		output.String())
+}
+
+func TestShowExecutionActionRun_WhenJSONRequested_ThenPrintsExecutionWithStep(t *testing.T) {
+	t.Parallel()
+
+	workflow := actionTestWorkflow()
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetWorkflowVersion(workflowAPI.WorkflowId("release"), "1.2.0").Return(&workflow, nil)
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().GetExecution(workflowAPI.ExecutionId("exec-1")).Return(&workflowAPI.Execution{
+		Id:              "exec-1",
+		WorkflowId:      "release",
+		WorkflowVersion: "1.2.0",
+		Status:          workflowAPI.ExecutionStatusRunning,
+		StateValues:     map[string]any{},
+		StepId:          "prepare",
+	}, nil)
+
+	var output bytes.Buffer
+	err := NewShowExecutionAction(mockRepository, mockService, "exec-1", &output, true).Run()
+
+	require.NoError(t, err)
+	var result map[string]any
+	require.NoError(t, json.Unmarshal(output.Bytes(), &result))
+	assert.Equal(t, "exec-1", result["id"])
+	assert.Equal(t, "running", result["status"])
+	assert.Equal(t, float64(1), result["stepNumber"])
+	assert.Equal(t, float64(2), result["stepsCount"])
+	assert.Equal(t, "Prepare", result["step"].(map[string]any)["name"])
+}
+
+func TestShowExecutionActionRun_WhenExecutionNotFound_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().GetExecution(workflowAPI.ExecutionId("missing")).Return(nil, workflowAPI.ErrExecutionNotFound)
+
+	err := NewShowExecutionAction(workflowAPI.NewMockRepository(t), mockService, "missing", &bytes.Buffer{}, false).Run()
+
+	require.ErrorIs(t, err, workflowAPI.ErrExecutionNotFound)
+	assert.Contains(t, err.Error(), "show execution")
+}
@@ -0,0 +1,125 @@
+package action
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+type ShowWorkflowAction struct {
+	workflowRepository workflowAPI.Repository
+	workflowId         workflowAPI.WorkflowId
+	output             io.Writer
+	json               bool
+}
+
+func NewShowWorkflowAction(workflowRepository workflowAPI.Repository, workflowId workflowAPI.WorkflowId, output io.Writer, json bool) *ShowWorkflowAction {
+	return &ShowWorkflowAction{
+		workflowRepository: workflowRepository,
+		workflowId:         workflowId,
+		output:             output,
+		json:               json,
+	}
+}
+
+func (action *ShowWorkflowAction) Run() error {
+	workflow, err := action.workflowRepository.GetWorkflowById(action.workflowId)
+	if err != nil {
+		return fmt.Errorf("show workflow %s: %w", action.workflowId, err)
+	}
+
+	if action.json {
+		err = writeJSON(action.output, workflow)
+	} else {
+		err = action.writeWorkflow(*workflow)
+	}
+	if err != nil {
+		return fmt.Errorf("show workflow %s: %w", action.workflowId, err)
+	}
+
+	return nil
+}
+
+func (action *ShowWorkflowAction) writeWorkflow(workflow workflowAPI.Workflow) error {
+	details := [][]string{
+		{"ID:", string(workflow.Metadata.ID)},
+		{"Name:", workflow.Metadata.Name},
+		{"Version:", workflow.Metadata.Version},
+		{"Description:", workflow.Metadata.Description},
+	}
+	if workflow.Provenance != nil {
+		details = append(details, []string{"Source:", workflow.Provenance.String()})
+	}
+
+	if err := writeTable(action.output, nil, details); err != nil {
+		return err
+	}
+
+	if len(workflow.State) > 0 {
+		keys := make([]string, 0, len(workflow.State))
+		for key := range workflow.State {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		rows := make([][]string, 0, len(keys))
+		for _, key := range keys {
+			rows = append(rows, []string{key, formatValue(workflow.State[key])})
+		}
+
+		_, _ = fmt.Fprintln(action.output)
+		if err := writeTable(action.output, []string{"STATE", "SCHEMA"}, rows); err != nil {
+			return err
+		}
+	}
+
+	rows := make([][]string, 0, len(workflow.Steps))
+	for index, step := range workflow.Steps {
+		approval := ""
+		if step.Approval != nil {
+			approval = "required"
+		}
+
+		invokedWorkflow := ""
+		if step.Workflow != nil {
+			invokedWorkflow = string(step.Workflow.WorkflowId)
+		}
+
+		rows = append(rows, []string{
+			strconv.Itoa(index + 1),
+			step.ID,
+			step.Name,
+			strings.Join(step.Outputs, ", "),
+			approval,
+			invokedWorkflow,
+			nextSteps(workflow, index),
+		})
+	}
+
+	_, _ = fmt.Fprintln(action.output)
+	return writeTable(action.output, []string{"#", "STEP", "NAME", "OUTPUTS", "APPROVAL", "WORKFLOW", "NEXT"}, rows)
+}
+
+// nextSteps describes where an execution goes after completing the step at the given index.
+func nextSteps(workflow workflowAPI.Workflow, index int) string {
+	step := workflow.Steps[index]
+
+	var next []string
+	for _, transition := range step.Transitions {
+		if transition.When == "" {
+			return strings.Join(append(next, transition.Next), "; ")
+		}
+
+		next = append(next, fmt.Sprintf("%s if %s", transition.Next, transition.When))
+	}
+
+	if step.Terminal || index == len(workflow.Steps)-1 {
+		return strings.Join(append(next, "end"), "; ")
+	}
+
+	return strings.Join(append(next, workflow.Steps[index+1].ID), "; ")
+}
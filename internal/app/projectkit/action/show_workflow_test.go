@@ -0,0 +1,71 @@
+package action
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/invopop/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+func TestShowWorkflowActionRun_WhenWorkflowExists_ThenPrintsStateAndSteps(t *testing.T) {
+	t.Parallel()
+
+	workflow := actionTestWorkflow()
+	workflow.Provenance = nil
+	workflow.State = map[string]*jsonschema.Schema{"version": {Type: "string"}}
+	workflow.Steps[0].Transitions = []workflowAPI.Transition{{When: "version == 'skip'", Next: "prepare"}}
+	workflow.Steps[1].Workflow = &workflowAPI.Invocation{WorkflowId: "changelog"}
+
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetWorkflowById(workflowAPI.WorkflowId("release")).Return(&workflow, nil)
+
+	var output bytes.Buffer
+	err := NewShowWorkflowAction(mockRepository, "release", &output, false).Run()
+
+	require.NoError(t, err)
+	assert.Equal(t, ""+
+		"ID:           release\n"+
+		"Name:         Release\n"+
+		"Version:      1.2.0\n"+
+		"Description:  Release a new version.\n"+
+		"\n"+
+		"STATE    SCHEMA\n"+
+		"version  {\"type\":\"string\"}\n"+
+		"\n"+
+		"#  STEP     NAME     OUTPUTS  APPROVAL  WORKFLOW   NEXT\n"+
+		"1  prepare  Prepare  version                       prepare if version == 'skip'; publish\n"+
+		"2  publish  Publish           required  changelog  end\n",
+		output.String())
+}
+
+func TestShowWorkflowActionRun_WhenJSONRequested_ThenPrintsWorkflow(t *testing.T) {
+	t.Parallel()
+
+	workflow := actionTestWorkflow()
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetWorkflowById(workflowAPI.WorkflowId("release")).Return(&workflow, nil)
+
+	var output bytes.Buffer
+	err := NewShowWorkflowAction(mockRepository, "release", &output, true).Run()
+
+	require.NoError(t, err)
+	var result workflowAPI.Workflow
+	require.NoError(t, json.Unmarshal(output.Bytes(), &result))
+	assert.Equal(t, workflow, result)
+}
+
+func TestShowWorkflowActionRun_WhenWorkflowNotFound_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetWorkflowById(workflowAPI.WorkflowId("missing")).Return(nil, workflowAPI.ErrWorkflowNotFound)
+
+	err := NewShowWorkflowAction(mockRepository, "missing", &bytes.Buffer{}, false).Run()
+
+	require.ErrorIs(t, err, workflowAPI.ErrWorkflowNotFound)
+}
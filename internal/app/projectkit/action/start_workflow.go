@@ -0,0 +1,50 @@
+package action
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+type StartWorkflowAction struct {
+	workflowRepository workflowAPI.Repository
+	workflowService    workflowAPI.Service
+	workflowId         workflowAPI.WorkflowId
+	output             io.Writer
+	json               bool
+}
+
+func NewStartWorkflowAction(workflowRepository workflowAPI.Repository, workflowService workflowAPI.Service, workflowId workflowAPI.WorkflowId, output io.Writer, json bool) *StartWorkflowAction {
+	return &StartWorkflowAction{
+		workflowRepository: workflowRepository,
+		workflowService:    workflowService,
+		workflowId:         workflowId,
+		output:             output,
+		json:               json,
+	}
+}
+
+func (action *StartWorkflowAction) Run() error {
+	executionId, err := action.workflowService.Execute(humanActor(), action.workflowId)
+	if err != nil {
+		return fmt.Errorf("start workflow: %w", err)
+	}
+
+	slog.Info("Workflow execution started.",
+		slog.String("workflowId", string(action.workflowId)),
+		slog.String("executionId", string(executionId)),
+	)
+
+	execution, err := action.workflowService.GetExecution(executionId)
+	if err != nil {
+		return fmt.Errorf("start workflow: %w", err)
+	}
+
+	if err := writeExecutionStatus(action.output, action.workflowRepository, *execution, action.json); err != nil {
+		return fmt.Errorf("start workflow: %w", err)
+	}
+
+	return nil
+}
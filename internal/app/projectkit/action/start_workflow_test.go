@@ -0,0 +1,48 @@
+package action
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+func TestStartWorkflowActionRun_WhenWorkflowExists_ThenStartsAndPrintsStatus(t *testing.T) {
+	t.Parallel()
+
+	workflow := actionTestWorkflow()
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetWorkflowVersion(workflowAPI.WorkflowId("release"), "1.2.0").Return(&workflow, nil)
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().Execute(humanActor(), workflowAPI.WorkflowId("release")).Return("exec-1", nil)
+	mockService.EXPECT().GetExecution(workflowAPI.ExecutionId("exec-1")).Return(&workflowAPI.Execution{
+		Id:              "exec-1",
+		WorkflowId:      "release",
+		WorkflowVersion: "1.2.0",
+		Status:          workflowAPI.ExecutionStatusPending,
+		StateValues:     map[string]any{},
+		StepId:          "prepare",
+	}, nil)
+
+	var output bytes.Buffer
+	err := NewStartWorkflowAction(mockRepository, mockService, "release", &output, false).Run()
+
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "Execution:  exec-1\n")
+	assert.Contains(t, output.String(), "Step:       1/2 prepare (Prepare)\n")
+}
+
+func TestStartWorkflowActionRun_WhenExecuteFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().Execute(humanActor(), workflowAPI.WorkflowId("missing")).Return("", workflowAPI.ErrWorkflowNotFound)
+
+	err := NewStartWorkflowAction(workflowAPI.NewMockRepository(t), mockService, "missing", &bytes.Buffer{}, false).Run()
+
+	require.ErrorIs(t, err, workflowAPI.ErrWorkflowNotFound)
+	assert.Contains(t, err.Error(), "start workflow")
+}
@@ -6,10 +6,14 @@ import (
 	"os"
 
 	"github.com/orbiqd/orbiqd-projectkit/internal/app/projectkit/loader"
+	"github.com/orbiqd/orbiqd-projectkit/internal/pkg/conflict"
+	"github.com/orbiqd/orbiqd-projectkit/internal/pkg/source"
 	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	mcpAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
 	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
 	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+	conflictAPI "github.com/orbiqd/orbiqd-projectkit/pkg/conflict"
 	standardAPI "github.com/orbiqd/orbiqd-projectkit/pkg/doc/standard"
 	projectAPI "github.com/orbiqd/orbiqd-projectkit/pkg/project"
 	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
@@ -17,38 +21,59 @@ import (
 
 type UpdateAction struct {
 	config                projectAPI.Config
+	projectFs             projectAPI.Fs
+	locked                bool
 	sourceResolver        sourceAPI.Resolver
 	instructionRepository instructionAPI.Repository
 	skillRepository       skillAPI.Repository
 	workflowRepository    workflowAPI.Repository
 	mcpRepository         mcpAPI.Repository
+	toolRepository        toolAPI.Repository
 	standardRepository    standardAPI.Repository
 }
 
 func NewUpdateAction(
 	config projectAPI.Config,
+	projectFs projectAPI.Fs,
+	locked bool,
 	sourceResolver sourceAPI.Resolver,
 	instructionRepository instructionAPI.Repository,
 	skillRepository skillAPI.Repository,
 	workflowRepository workflowAPI.Repository,
 	mcpRepository mcpAPI.Repository,
+	toolRepository toolAPI.Repository,
 	standardRepository standardAPI.Repository,
 ) *UpdateAction {
 	return &UpdateAction{
 		config:                config,
+		projectFs:             projectFs,
+		locked:                locked,
 		sourceResolver:        sourceResolver,
 		instructionRepository: instructionRepository,
 		skillRepository:       skillRepository,
 		workflowRepository:    workflowRepository,
 		mcpRepository:         mcpRepository,
+		toolRepository:        toolRepository,
 		standardRepository:    standardRepository,
 	}
 }
 
 func (action *UpdateAction) Run() error {
+	var expectedLock *sourceAPI.Lock
+	if action.locked {
+		lock, err := source.LoadLock(action.projectFs)
+		if err != nil {
+			return fmt.Errorf("load lock: %w", err)
+		}
+
+		expectedLock = lock
+	}
+
+	sourceResolver := source.NewLockingResolver(action.sourceResolver, expectedLock)
+
 	var instructions []instructionAPI.Instructions
 	if action.config.AI != nil && action.config.AI.Instruction != nil {
-		instructionsSet, err := loader.LoadAiInstructionsFromConfig(*action.config.AI.Instruction, action.sourceResolver)
+		instructionsSet, err := loader.LoadAiInstructionsFromConfig(*action.config.AI.Instruction, sourceResolver)
 		if err != nil {
 			return fmt.Errorf("load instructions from config: %w", err)
 		}
@@ -58,7 +83,7 @@ func (action *UpdateAction) Run() error {
 
 	var skills []skillAPI.Skill
 	if action.config.AI != nil && action.config.AI.Skill != nil {
-		skillsSet, err := loader.LoadAiSkillsFromConfig(*action.config.AI.Skill, action.sourceResolver)
+		skillsSet, err := loader.LoadAiSkillsFromConfig(*action.config.AI.Skill, sourceResolver)
 		if err != nil {
 			return fmt.Errorf("load skills from config: %w", err)
 		}
@@ -68,7 +93,7 @@ func (action *UpdateAction) Run() error {
 
 	var workflows []workflowAPI.Workflow
 	if action.config.AI != nil && action.config.AI.Workflows != nil {
-		workflowsSet, err := loader.LoadWorkflowsFromConfig(*action.config.AI.Workflows, action.sourceResolver)
+		workflowsSet, err := loader.LoadWorkflowsFromConfig(*action.config.AI.Workflows, sourceResolver)
 		if err != nil {
 			return fmt.Errorf("load workflows from config: %w", err)
 		}
@@ -78,7 +103,7 @@ func (action *UpdateAction) Run() error {
 
 	var mcpServers []mcpAPI.MCPServer
 	if action.config.AI != nil && action.config.AI.MCP != nil {
-		mcpServersSet, err := loader.LoadAiMCPServersFromConfig(*action.config.AI.MCP, action.sourceResolver)
+		mcpServersSet, err := loader.LoadAiMCPServersFromConfig(*action.config.AI.MCP, sourceResolver)
 		if err != nil {
 			return fmt.Errorf("load mcp servers from config: %w", err)
 		}
@@ -86,9 +111,19 @@ func (action *UpdateAction) Run() error {
 		mcpServers = append(mcpServers, mcpServersSet...)
 	}
 
+	var tools []toolAPI.Tool
+	if action.config.AI != nil && action.config.AI.Tool != nil {
+		toolsSet, err := loader.LoadAiToolsFromConfig(*action.config.AI.Tool, sourceResolver)
+		if err != nil {
+			return fmt.Errorf("load tools from config: %w", err)
+		}
+
+		tools = append(tools, toolsSet...)
+	}
+
 	var standards []standardAPI.Standard
 	if action.config.Docs != nil && action.config.Docs.Standard != nil {
-		standardsSet, err := loader.LoadDocStandardsFromConfig(*action.config.Docs.Standard, action.sourceResolver)
+		standardsSet, err := loader.LoadDocStandardsFromConfig(*action.config.Docs.Standard, sourceResolver)
 		if err != nil {
 			return fmt.Errorf("load standards from config: %w", err)
 		}
@@ -97,7 +132,7 @@ func (action *UpdateAction) Run() error {
 	}
 
 	if action.config.Rulebook != nil {
-		rulebooks, err := loader.LoadRulebooksFromConfig(*action.config.Rulebook, action.sourceResolver)
+		rulebooks, err := loader.LoadRulebooksFromConfig(*action.config.Rulebook, sourceResolver)
 		if err != nil {
 			return fmt.Errorf("load rule books from config: %w", err)
 		}
@@ -107,6 +142,7 @@ func (action *UpdateAction) Run() error {
 			skills = append(skills, rulebook.AI.Skills...)
 			workflows = append(workflows, rulebook.AI.Workflows...)
 			mcpServers = append(mcpServers, rulebook.AI.MCPServers...)
+			tools = append(tools, rulebook.AI.Tools...)
 			standards = append(standards, rulebook.Doc.Standards...)
 		}
 	}
@@ -124,6 +160,58 @@ func (action *UpdateAction) Run() error {
 		},
 	})
 
+	conflictResolver := conflict.NewResolver(action.config.Conflict)
+
+	instructions, err = conflict.ResolveInstructions(conflictResolver, instructions)
+	if err != nil {
+		return fmt.Errorf("resolve instruction conflicts: %w", err)
+	}
+
+	skills, err = conflict.Resolve(conflictResolver, conflictAPI.KindSkill, skills, func(skill skillAPI.Skill) (string, *sourceAPI.Provenance) {
+		return string(skill.Metadata.Name), skill.Provenance
+	})
+	if err != nil {
+		return fmt.Errorf("resolve skill conflicts: %w", err)
+	}
+
+	workflows, err = conflict.Resolve(conflictResolver, conflictAPI.KindWorkflow, workflows, func(workflow workflowAPI.Workflow) (string, *sourceAPI.Provenance) {
+		return string(workflow.Metadata.ID), workflow.Provenance
+	})
+	if err != nil {
+		return fmt.Errorf("resolve workflow conflicts: %w", err)
+	}
+
+	mcpServers, err = conflict.Resolve(conflictResolver, conflictAPI.KindMCPServer, mcpServers, func(mcpServer mcpAPI.MCPServer) (string, *sourceAPI.Provenance) {
+		return mcpServer.Name, mcpServer.Provenance
+	})
+	if err != nil {
+		return fmt.Errorf("resolve mcp server conflicts: %w", err)
+	}
+
+	tools, err = conflict.Resolve(conflictResolver, conflictAPI.KindTool, tools, func(tool toolAPI.Tool) (string, *sourceAPI.Provenance) {
+		return string(tool.Metadata.ID), tool.Provenance
+	})
+	if err != nil {
+		return fmt.Errorf("resolve tool conflicts: %w", err)
+	}
+
+	standards, err = conflict.Resolve(conflictResolver, conflictAPI.KindStandard, standards, func(standard standardAPI.Standard) (string, *sourceAPI.Provenance) {
+		return string(standard.Metadata.Id), standard.Provenance
+	})
+	if err != nil {
+		return fmt.Errorf("resolve standard conflicts: %w", err)
+	}
+
+	if err := workflowAPI.ValidateInvocations(workflows); err != nil {
+		return fmt.Errorf("validate workflow invocations: %w", err)
+	}
+
+	if err := validateWorkflowReferences(workflows, skills, tools); err != nil {
+		return fmt.Errorf("validate workflow references: %w", err)
+	}
+
+	action.reportShadowed(conflictResolver.Shadowed())
+
 	err = action.standardRepository.RemoveAll()
 	if err != nil {
 		return fmt.Errorf("remove all standards from repository: %w", err)
@@ -133,6 +221,10 @@ func (action *UpdateAction) Run() error {
 		if err != nil {
 			return fmt.Errorf("add standard to repository: %w", err)
 		}
+		slog.Debug("Standard added to repository.",
+			slog.String("standardId", string(standardItem.Metadata.Id)),
+			provenanceAttr(standardItem.Provenance),
+		)
 	}
 	slog.Info("Standards added to repository.", slog.Int("count", len(standards)))
 
@@ -145,6 +237,13 @@ func (action *UpdateAction) Run() error {
 		if err != nil {
 			return fmt.Errorf("add instructions to repository: %w", err)
 		}
+		for _, provenance := range instructionsItem.Provenance {
+			slog.Debug("Instructions added to repository.",
+				slog.String("category", string(instructionsItem.Category)),
+				slog.Int("rulesCount", len(instructionsItem.Rules)),
+				provenanceAttr(&provenance),
+			)
+		}
 	}
 	slog.Info("Instructions added to repository.", slog.Int("count", len(instructions)))
 
@@ -157,6 +256,10 @@ func (action *UpdateAction) Run() error {
 		if err != nil {
 			return fmt.Errorf("add skill: %w", err)
 		}
+		slog.Debug("Skill added to repository.",
+			slog.String("skillName", string(skill.Metadata.Name)),
+			provenanceAttr(skill.Provenance),
+		)
 	}
 	slog.Info("Skills added to repository.", slog.Int("count", len(skills)))
 
@@ -169,6 +272,10 @@ func (action *UpdateAction) Run() error {
 		if err != nil {
 			return fmt.Errorf("add workflow: %w", err)
 		}
+		slog.Debug("Workflow added to repository.",
+			slog.String("workflowId", string(workflow.Metadata.ID)),
+			provenanceAttr(workflow.Provenance),
+		)
 	}
 	slog.Info("Workflows added to repository.", slog.Int("count", len(workflows)))
 
@@ -181,12 +288,98 @@ func (action *UpdateAction) Run() error {
 		if err != nil {
 			return fmt.Errorf("add mcp server: %w", err)
 		}
+		slog.Debug("MCP server added to repository.",
+			slog.String("mcpServerName", mcpServer.Name),
+			provenanceAttr(mcpServer.Provenance),
+		)
 	}
 	slog.Info("MCP servers added to repository.", slog.Int("count", len(mcpServers)))
 
+	err = action.toolRepository.RemoveAll()
+	if err != nil {
+		return fmt.Errorf("remove all tools from repository: %w", err)
+	}
+	for _, tool := range tools {
+		err := action.toolRepository.AddTool(tool)
+		if err != nil {
+			return fmt.Errorf("add tool: %w", err)
+		}
+		slog.Debug("Tool added to repository.",
+			slog.String("toolId", string(tool.Metadata.ID)),
+			provenanceAttr(tool.Provenance),
+		)
+	}
+	slog.Info("Tools added to repository.", slog.Int("count", len(tools)))
+
+	if action.locked {
+		return nil
+	}
+
+	lock := sourceResolver.Lock()
+	err = source.SaveLock(action.projectFs, lock)
+	if err != nil {
+		return fmt.Errorf("save lock: %w", err)
+	}
+	slog.Info("Lock written.", slog.String("path", sourceAPI.LockFileName), slog.Int("sources", len(lock.Sources)))
+
 	return nil
 }
 
+// validateWorkflowReferences checks that the skills and tools referenced by workflow steps are among the skills
+// and tools being added, so that an invalid reference fails the update before any repository is touched.
+func validateWorkflowReferences(workflows []workflowAPI.Workflow, skills []skillAPI.Skill, tools []toolAPI.Tool) error {
+	skillNames := make(map[skillAPI.Name]bool, len(skills))
+	for _, skill := range skills {
+		skillNames[skill.Metadata.Name] = true
+	}
+
+	toolIds := make(map[toolAPI.ToolId]bool, len(tools))
+	for _, tool := range tools {
+		toolIds[tool.Metadata.ID] = true
+	}
+
+	for _, workflow := range workflows {
+		for _, step := range workflow.Steps {
+			for _, skillName := range step.Skills {
+				if !skillNames[skillName] {
+					return fmt.Errorf("workflow %s step %s skill %s: %w", workflow.Metadata.ID, step.ID, skillName, skillAPI.ErrSkillNotFound)
+				}
+			}
+
+			for _, toolId := range step.Tools {
+				if !toolIds[toolId] {
+					return fmt.Errorf("workflow %s step %s tool %s: %w", workflow.Metadata.ID, step.ID, toolId, toolAPI.ErrToolNotFound)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (action *UpdateAction) reportShadowed(shadowed []conflictAPI.Shadow) {
+	for _, shadow := range shadowed {
+		slog.Warn("Artifact shadowed.",
+			slog.String("kind", string(shadow.Kind)),
+			slog.String("name", shadow.Name),
+			slog.String("winner", shadow.Winner.String()),
+			slog.String("shadowed", shadow.Shadowed.String()),
+		)
+	}
+
+	if len(shadowed) > 0 {
+		slog.Info("Conflicts resolved.", slog.Int("shadowedCount", len(shadowed)))
+	}
+}
+
+func provenanceAttr(provenance *sourceAPI.Provenance) slog.Attr {
+	if provenance == nil {
+		return slog.Group("provenance")
+	}
+
+	return slog.Any("provenance", *provenance)
+}
+
 func (action *UpdateAction) resolveExecutablePath() (string, error) {
 	if envPath := os.Getenv("BRIEFKIT_BINARY_PATH"); envPath != "" {
 		return envPath, nil
@@ -4,11 +4,14 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/orbiqd/orbiqd-projectkit/internal/pkg/source"
 	aiAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai"
 	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	mcpAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
 	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
 	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+	conflictAPI "github.com/orbiqd/orbiqd-projectkit/pkg/conflict"
 	docAPI "github.com/orbiqd/orbiqd-projectkit/pkg/doc"
 	standardAPI "github.com/orbiqd/orbiqd-projectkit/pkg/doc/standard"
 	projectAPI "github.com/orbiqd/orbiqd-projectkit/pkg/project"
@@ -183,6 +186,18 @@ func configWithMCP(uri string) projectAPI.Config {
 	}
 }
 
+func configWithTools(uri string) projectAPI.Config {
+	return projectAPI.Config{
+		AI: &aiAPI.Config{
+			Tool: &toolAPI.Config{
+				Sources: []toolAPI.SourceConfig{
+					{URI: uri},
+				},
+			},
+		},
+	}
+}
+
 func configWithRulebook(uri string) projectAPI.Config {
 	return projectAPI.Config{
 		Rulebook: &rulebook.Config{
@@ -201,6 +216,7 @@ func TestUpdateActionRun_WhenEmptyConfig_ThenClearsAllRepos(t *testing.T) {
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	mockStandardRepo.EXPECT().RemoveAll().Return(nil)
@@ -208,6 +224,7 @@ func TestUpdateActionRun_WhenEmptyConfig_ThenClearsAllRepos(t *testing.T) {
 	mockSkillRepo.EXPECT().RemoveAll().Return(nil)
 	mockWorkflowRepo.EXPECT().RemoveAllWorkflows().Return(nil)
 	mockMcpRepo.EXPECT().RemoveAll().Return(nil)
+	mockToolRepo.EXPECT().RemoveAll().Return(nil)
 	mockMcpRepo.EXPECT().AddMCPServer(mock.MatchedBy(func(server mcpAPI.MCPServer) bool {
 		return server.Name == "projectkit" &&
 			server.STDIO != nil &&
@@ -218,7 +235,7 @@ func TestUpdateActionRun_WhenEmptyConfig_ThenClearsAllRepos(t *testing.T) {
 	})).Return(nil)
 
 	config := projectAPI.Config{}
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -233,6 +250,7 @@ func TestUpdateActionRun_WhenInstructionsConfigured_ThenUpdatesInstructionRepo(t
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	fs := validInstructionFs(t, "test-category", []string{"Rule one"})
@@ -244,10 +262,11 @@ func TestUpdateActionRun_WhenInstructionsConfigured_ThenUpdatesInstructionRepo(t
 	mockSkillRepo.EXPECT().RemoveAll().Return(nil)
 	mockWorkflowRepo.EXPECT().RemoveAllWorkflows().Return(nil)
 	mockMcpRepo.EXPECT().RemoveAll().Return(nil)
+	mockToolRepo.EXPECT().RemoveAll().Return(nil)
 	mockMcpRepo.EXPECT().AddMCPServer(mock.AnythingOfType("mcp.MCPServer")).Return(nil)
 
 	config := configWithInstructions("file://./instructions")
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -262,6 +281,7 @@ func TestUpdateActionRun_WhenSkillsConfigured_ThenUpdatesSkillRepo(t *testing.T)
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	fs := validSkillFs(t, "test-skill", "Test skill", "Test instructions")
@@ -273,10 +293,11 @@ func TestUpdateActionRun_WhenSkillsConfigured_ThenUpdatesSkillRepo(t *testing.T)
 	mockSkillRepo.EXPECT().AddSkill(mock.AnythingOfType("skill.Skill")).Return(nil)
 	mockWorkflowRepo.EXPECT().RemoveAllWorkflows().Return(nil)
 	mockMcpRepo.EXPECT().RemoveAll().Return(nil)
+	mockToolRepo.EXPECT().RemoveAll().Return(nil)
 	mockMcpRepo.EXPECT().AddMCPServer(mock.AnythingOfType("mcp.MCPServer")).Return(nil)
 
 	config := configWithSkills("file://./skills")
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -291,6 +312,7 @@ func TestUpdateActionRun_WhenWorkflowsConfigured_ThenUpdatesWorkflowRepo(t *test
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	fs := validWorkflowFs(t, "test-workflow", "Test Workflow", "Test description")
@@ -302,10 +324,11 @@ func TestUpdateActionRun_WhenWorkflowsConfigured_ThenUpdatesWorkflowRepo(t *test
 	mockWorkflowRepo.EXPECT().RemoveAllWorkflows().Return(nil)
 	mockWorkflowRepo.EXPECT().AddWorkflow(mock.AnythingOfType("workflow.Workflow")).Return(nil)
 	mockMcpRepo.EXPECT().RemoveAll().Return(nil)
+	mockToolRepo.EXPECT().RemoveAll().Return(nil)
 	mockMcpRepo.EXPECT().AddMCPServer(mock.AnythingOfType("mcp.MCPServer")).Return(nil)
 
 	config := configWithWorkflows("file://./workflows")
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -320,6 +343,7 @@ func TestUpdateActionRun_WhenMCPServersConfigured_ThenUpdatesMCPRepo(t *testing.
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	fs := validMCPServerFs(t, "test-server", "/usr/local/bin/test")
@@ -330,16 +354,88 @@ func TestUpdateActionRun_WhenMCPServersConfigured_ThenUpdatesMCPRepo(t *testing.
 	mockSkillRepo.EXPECT().RemoveAll().Return(nil)
 	mockWorkflowRepo.EXPECT().RemoveAllWorkflows().Return(nil)
 	mockMcpRepo.EXPECT().RemoveAll().Return(nil)
+	mockToolRepo.EXPECT().RemoveAll().Return(nil)
 	mockMcpRepo.EXPECT().AddMCPServer(mock.AnythingOfType("mcp.MCPServer")).Times(2).Return(nil)
 
 	config := configWithMCP("file://./mcp")
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
+
+	err := action.Run()
+
+	require.NoError(t, err)
+}
+
+func TestUpdateActionRun_WhenToolsConfigured_ThenUpdatesToolRepo(t *testing.T) {
+	t.Setenv("BRIEFKIT_BINARY_PATH", "/test/bin/projectkit")
+
+	mockResolver := sourceAPI.NewMockResolver(t)
+	mockInstructionRepo := instructionAPI.NewMockRepository(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockStandardRepo := standardAPI.NewMockRepository(t)
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "go-test.yaml", []byte(`metadata:
+  id: go-test
+  name: Go Test
+  description: A tool for running go tests.
+tool:
+  commands:
+    - go test
+`), 0644))
+	mockResolver.EXPECT().Resolve("file://./tools").Return(fs, nil)
+
+	mockStandardRepo.EXPECT().RemoveAll().Return(nil)
+	mockInstructionRepo.EXPECT().RemoveAll().Return(nil)
+	mockSkillRepo.EXPECT().RemoveAll().Return(nil)
+	mockWorkflowRepo.EXPECT().RemoveAllWorkflows().Return(nil)
+	mockMcpRepo.EXPECT().RemoveAll().Return(nil)
+	mockMcpRepo.EXPECT().AddMCPServer(mock.AnythingOfType("mcp.MCPServer")).Return(nil)
+	mockToolRepo.EXPECT().RemoveAll().Return(nil)
+	mockToolRepo.EXPECT().AddTool(mock.MatchedBy(func(tool toolAPI.Tool) bool {
+		return tool.Metadata.ID == "go-test" && tool.Provenance.SourceURI == "file://./tools"
+	})).Return(nil)
+
+	config := configWithTools("file://./tools")
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
 	require.NoError(t, err)
 }
 
+func TestUpdateActionRun_WhenToolRemoveAllFails_ThenReturnsError(t *testing.T) {
+	t.Setenv("BRIEFKIT_BINARY_PATH", "/test/bin/projectkit")
+
+	mockResolver := sourceAPI.NewMockResolver(t)
+	mockInstructionRepo := instructionAPI.NewMockRepository(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockStandardRepo := standardAPI.NewMockRepository(t)
+
+	removeErr := errors.New("remove all tools error")
+	mockStandardRepo.EXPECT().RemoveAll().Return(nil)
+	mockInstructionRepo.EXPECT().RemoveAll().Return(nil)
+	mockSkillRepo.EXPECT().RemoveAll().Return(nil)
+	mockWorkflowRepo.EXPECT().RemoveAllWorkflows().Return(nil)
+	mockMcpRepo.EXPECT().RemoveAll().Return(nil)
+	mockMcpRepo.EXPECT().AddMCPServer(mock.AnythingOfType("mcp.MCPServer")).Return(nil)
+	mockToolRepo.EXPECT().RemoveAll().Return(removeErr)
+
+	config := projectAPI.Config{}
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
+
+	err := action.Run()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "remove all tools from repository")
+	assert.ErrorIs(t, err, removeErr)
+}
+
 func TestUpdateActionRun_WhenStandardsConfigured_ThenUpdatesStandardRepo(t *testing.T) {
 	t.Setenv("BRIEFKIT_BINARY_PATH", "/test/bin/projectkit")
 
@@ -348,6 +444,7 @@ func TestUpdateActionRun_WhenStandardsConfigured_ThenUpdatesStandardRepo(t *test
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	fs := validStandardFsForUpdate(t, "Test Standard", "1.0.0")
@@ -359,10 +456,11 @@ func TestUpdateActionRun_WhenStandardsConfigured_ThenUpdatesStandardRepo(t *test
 	mockSkillRepo.EXPECT().RemoveAll().Return(nil)
 	mockWorkflowRepo.EXPECT().RemoveAllWorkflows().Return(nil)
 	mockMcpRepo.EXPECT().RemoveAll().Return(nil)
+	mockToolRepo.EXPECT().RemoveAll().Return(nil)
 	mockMcpRepo.EXPECT().AddMCPServer(mock.AnythingOfType("mcp.MCPServer")).Return(nil)
 
 	config := configWithStandards("file://./standards")
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -377,13 +475,14 @@ func TestUpdateActionRun_WhenLoadInstructionsFails_ThenReturnsError(t *testing.T
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	loadErr := errors.New("load instructions error")
 	mockResolver.EXPECT().Resolve("file://./instructions").Return(nil, loadErr)
 
 	config := configWithInstructions("file://./instructions")
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -400,13 +499,14 @@ func TestUpdateActionRun_WhenLoadSkillsFails_ThenReturnsError(t *testing.T) {
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	loadErr := errors.New("load skills error")
 	mockResolver.EXPECT().Resolve("file://./skills").Return(nil, loadErr)
 
 	config := configWithSkills("file://./skills")
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -423,13 +523,14 @@ func TestUpdateActionRun_WhenLoadWorkflowsFails_ThenReturnsError(t *testing.T) {
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	loadErr := errors.New("load workflows error")
 	mockResolver.EXPECT().Resolve("file://./workflows").Return(nil, loadErr)
 
 	config := configWithWorkflows("file://./workflows")
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -446,13 +547,14 @@ func TestUpdateActionRun_WhenLoadStandardsFails_ThenReturnsError(t *testing.T) {
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	loadErr := errors.New("load standards error")
 	mockResolver.EXPECT().Resolve("file://./standards").Return(nil, loadErr)
 
 	config := configWithStandards("file://./standards")
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -469,13 +571,14 @@ func TestUpdateActionRun_WhenLoadRulebooksFails_ThenReturnsError(t *testing.T) {
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	loadErr := errors.New("load rulebooks error")
 	mockResolver.EXPECT().Resolve("file://./rulebook").Return(nil, loadErr)
 
 	config := configWithRulebook("file://./rulebook")
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -492,13 +595,14 @@ func TestUpdateActionRun_WhenStandardRemoveAllFails_ThenReturnsError(t *testing.
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	removeErr := errors.New("remove all standards error")
 	mockStandardRepo.EXPECT().RemoveAll().Return(removeErr)
 
 	config := projectAPI.Config{}
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -515,6 +619,7 @@ func TestUpdateActionRun_WhenStandardAddFails_ThenReturnsError(t *testing.T) {
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	fs := validStandardFsForUpdate(t, "Test Standard", "1.0.0")
@@ -525,7 +630,7 @@ func TestUpdateActionRun_WhenStandardAddFails_ThenReturnsError(t *testing.T) {
 	mockStandardRepo.EXPECT().AddStandard(mock.AnythingOfType("standard.Standard")).Return(addErr)
 
 	config := configWithStandards("file://./standards")
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -542,6 +647,7 @@ func TestUpdateActionRun_WhenInstructionRemoveAllFails_ThenReturnsError(t *testi
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	removeErr := errors.New("remove all instructions error")
@@ -549,7 +655,7 @@ func TestUpdateActionRun_WhenInstructionRemoveAllFails_ThenReturnsError(t *testi
 	mockInstructionRepo.EXPECT().RemoveAll().Return(removeErr)
 
 	config := projectAPI.Config{}
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -566,6 +672,7 @@ func TestUpdateActionRun_WhenInstructionAddFails_ThenReturnsError(t *testing.T)
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	fs := validInstructionFs(t, "test-category", []string{"Rule one"})
@@ -577,7 +684,7 @@ func TestUpdateActionRun_WhenInstructionAddFails_ThenReturnsError(t *testing.T)
 	mockInstructionRepo.EXPECT().AddInstructions(mock.AnythingOfType("instruction.Instructions")).Return(addErr)
 
 	config := configWithInstructions("file://./instructions")
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -594,6 +701,7 @@ func TestUpdateActionRun_WhenSkillRemoveAllFails_ThenReturnsError(t *testing.T)
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	removeErr := errors.New("remove all skills error")
@@ -602,7 +710,7 @@ func TestUpdateActionRun_WhenSkillRemoveAllFails_ThenReturnsError(t *testing.T)
 	mockSkillRepo.EXPECT().RemoveAll().Return(removeErr)
 
 	config := projectAPI.Config{}
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -619,6 +727,7 @@ func TestUpdateActionRun_WhenSkillAddFails_ThenReturnsError(t *testing.T) {
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	fs := validSkillFs(t, "test-skill", "Test skill", "Test instructions")
@@ -631,7 +740,7 @@ func TestUpdateActionRun_WhenSkillAddFails_ThenReturnsError(t *testing.T) {
 	mockSkillRepo.EXPECT().AddSkill(mock.AnythingOfType("skill.Skill")).Return(addErr)
 
 	config := configWithSkills("file://./skills")
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -640,6 +749,78 @@ func TestUpdateActionRun_WhenSkillAddFails_ThenReturnsError(t *testing.T) {
 	assert.ErrorIs(t, err, addErr)
 }
 
+func configWithSkillSources(uris ...string) projectAPI.Config {
+	var sources []skillAPI.SourceConfig
+	for _, uri := range uris {
+		sources = append(sources, skillAPI.SourceConfig{URI: uri})
+	}
+
+	return projectAPI.Config{
+		AI: &aiAPI.Config{
+			Skill: &skillAPI.Config{
+				Sources: sources,
+			},
+		},
+	}
+}
+
+func TestUpdateActionRun_WhenSkillProvidedTwiceWithoutPolicy_ThenReturnsConflictError(t *testing.T) {
+	t.Parallel()
+
+	mockResolver := sourceAPI.NewMockResolver(t)
+	mockInstructionRepo := instructionAPI.NewMockRepository(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockStandardRepo := standardAPI.NewMockRepository(t)
+
+	mockResolver.EXPECT().Resolve("file://./team-skills").Return(validSkillFs(t, "test-skill", "Team skill", "Team instructions"), nil)
+	mockResolver.EXPECT().Resolve("file://./local-skills").Return(validSkillFs(t, "test-skill", "Local skill", "Local instructions"), nil)
+
+	config := configWithSkillSources("file://./team-skills", "file://./local-skills")
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
+
+	err := action.Run()
+
+	require.ErrorIs(t, err, conflictAPI.ErrConflict)
+	assert.Contains(t, err.Error(), "skill test-skill provided by test-skill from file://./team-skills and test-skill from file://./local-skills")
+}
+
+func TestUpdateActionRun_WhenSkillProvidedTwiceWithLastWins_ThenStoresLastSkill(t *testing.T) {
+	t.Parallel()
+
+	mockResolver := sourceAPI.NewMockResolver(t)
+	mockInstructionRepo := instructionAPI.NewMockRepository(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockStandardRepo := standardAPI.NewMockRepository(t)
+
+	mockResolver.EXPECT().Resolve("file://./team-skills").Return(validSkillFs(t, "test-skill", "Team skill", "Team instructions"), nil)
+	mockResolver.EXPECT().Resolve("file://./local-skills").Return(validSkillFs(t, "test-skill", "Local skill", "Local instructions"), nil)
+
+	mockStandardRepo.EXPECT().RemoveAll().Return(nil)
+	mockInstructionRepo.EXPECT().RemoveAll().Return(nil)
+	mockSkillRepo.EXPECT().RemoveAll().Return(nil)
+	mockSkillRepo.EXPECT().AddSkill(mock.MatchedBy(func(skill skillAPI.Skill) bool {
+		return skill.Metadata.Description == "Local skill" && skill.Provenance.SourceURI == "file://./local-skills"
+	})).Return(nil).Once()
+	mockWorkflowRepo.EXPECT().RemoveAllWorkflows().Return(nil)
+	mockMcpRepo.EXPECT().RemoveAll().Return(nil)
+	mockToolRepo.EXPECT().RemoveAll().Return(nil)
+	mockMcpRepo.EXPECT().AddMCPServer(mock.AnythingOfType("mcp.MCPServer")).Return(nil)
+
+	config := configWithSkillSources("file://./team-skills", "file://./local-skills")
+	config.Conflict = &conflictAPI.Config{Policy: conflictAPI.PolicyLastWins}
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
+
+	err := action.Run()
+
+	require.NoError(t, err)
+}
+
 func TestUpdateActionRun_WhenWorkflowRemoveAllFails_ThenReturnsError(t *testing.T) {
 	t.Parallel()
 
@@ -648,6 +829,7 @@ func TestUpdateActionRun_WhenWorkflowRemoveAllFails_ThenReturnsError(t *testing.
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	removeErr := errors.New("remove all workflows error")
@@ -657,7 +839,7 @@ func TestUpdateActionRun_WhenWorkflowRemoveAllFails_ThenReturnsError(t *testing.
 	mockWorkflowRepo.EXPECT().RemoveAllWorkflows().Return(removeErr)
 
 	config := projectAPI.Config{}
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -674,6 +856,7 @@ func TestUpdateActionRun_WhenWorkflowAddFails_ThenReturnsError(t *testing.T) {
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	fs := validWorkflowFs(t, "test-workflow", "Test Workflow", "Test description")
@@ -687,7 +870,7 @@ func TestUpdateActionRun_WhenWorkflowAddFails_ThenReturnsError(t *testing.T) {
 	mockWorkflowRepo.EXPECT().AddWorkflow(mock.AnythingOfType("workflow.Workflow")).Return(addErr)
 
 	config := configWithWorkflows("file://./workflows")
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -696,12 +879,216 @@ func TestUpdateActionRun_WhenWorkflowAddFails_ThenReturnsError(t *testing.T) {
 	assert.ErrorIs(t, err, addErr)
 }
 
-func validRulebookFs(t *testing.T) afero.Fs {
+func workflowWithReferencesFs(t *testing.T, skillName string, toolId string) afero.Fs {
+	t.Helper()
+
+	fs := afero.NewMemMapFs()
+
+	workflow := `metadata:
+  id: test-workflow
+  name: Test Workflow
+  description: Test description
+  version: 1.0.0
+steps:
+  - id: step1
+    name: Step 1
+    description: First step
+    instructions:
+      - Do something
+    skills:
+      - ` + skillName + `
+    tools:
+      - ` + toolId + `
+`
+	require.NoError(t, afero.WriteFile(fs, "test-workflow.yaml", []byte(workflow), 0644))
+
+	return fs
+}
+
+func TestUpdateActionRun_WhenWorkflowReferencesExistingSkillAndTool_ThenUpdatesWorkflowRepo(t *testing.T) {
+	t.Setenv("BRIEFKIT_BINARY_PATH", "/test/bin/projectkit")
+
+	mockResolver := sourceAPI.NewMockResolver(t)
+	mockInstructionRepo := instructionAPI.NewMockRepository(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockStandardRepo := standardAPI.NewMockRepository(t)
+
+	toolFs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(toolFs, "go-test.yaml", []byte(`metadata:
+  id: go-test
+  name: Go Test
+  description: A tool for running go tests.
+tool:
+  commands:
+    - go test
+`), 0644))
+	mockResolver.EXPECT().Resolve("file://./skills").Return(validSkillFs(t, "test-skill", "Test skill", "# Instructions"), nil)
+	mockResolver.EXPECT().Resolve("file://./workflows").Return(workflowWithReferencesFs(t, "test-skill", "go-test"), nil)
+	mockResolver.EXPECT().Resolve("file://./tools").Return(toolFs, nil)
+
+	mockStandardRepo.EXPECT().RemoveAll().Return(nil)
+	mockInstructionRepo.EXPECT().RemoveAll().Return(nil)
+	mockSkillRepo.EXPECT().RemoveAll().Return(nil)
+	mockSkillRepo.EXPECT().AddSkill(mock.AnythingOfType("skill.Skill")).Return(nil)
+	mockWorkflowRepo.EXPECT().RemoveAllWorkflows().Return(nil)
+	mockWorkflowRepo.EXPECT().AddWorkflow(mock.MatchedBy(func(workflow workflowAPI.Workflow) bool {
+		return workflow.Steps[0].Skills[0] == "test-skill" && workflow.Steps[0].Tools[0] == "go-test"
+	})).Return(nil)
+	mockMcpRepo.EXPECT().RemoveAll().Return(nil)
+	mockMcpRepo.EXPECT().AddMCPServer(mock.AnythingOfType("mcp.MCPServer")).Return(nil)
+	mockToolRepo.EXPECT().RemoveAll().Return(nil)
+	mockToolRepo.EXPECT().AddTool(mock.AnythingOfType("tool.Tool")).Return(nil)
+
+	config := configWithWorkflows("file://./workflows")
+	config.AI.Skill = &skillAPI.Config{Sources: []skillAPI.SourceConfig{{URI: "file://./skills"}}}
+	config.AI.Tool = &toolAPI.Config{Sources: []toolAPI.SourceConfig{{URI: "file://./tools"}}}
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
+
+	err := action.Run()
+
+	require.NoError(t, err)
+}
+
+func TestUpdateActionRun_WhenWorkflowReferencesMissingSkill_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockResolver := sourceAPI.NewMockResolver(t)
+	mockInstructionRepo := instructionAPI.NewMockRepository(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockStandardRepo := standardAPI.NewMockRepository(t)
+
+	mockResolver.EXPECT().Resolve("file://./workflows").Return(workflowWithReferencesFs(t, "missing-skill", "go-test"), nil)
+
+	config := configWithWorkflows("file://./workflows")
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
+
+	err := action.Run()
+
+	require.ErrorIs(t, err, skillAPI.ErrSkillNotFound)
+	assert.Contains(t, err.Error(), "validate workflow references")
+}
+
+func TestUpdateActionRun_WhenWorkflowReferencesSkillOnlyInPreviousRun_ThenRemovesNothing(t *testing.T) {
+	t.Parallel()
+
+	mockResolver := sourceAPI.NewMockResolver(t)
+	mockInstructionRepo := instructionAPI.NewMockRepository(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockStandardRepo := standardAPI.NewMockRepository(t)
+
+	mockResolver.EXPECT().Resolve("file://./standards").Return(validStandardFsForUpdate(t, "test-standard", "1.0.0"), nil)
+	mockResolver.EXPECT().Resolve("file://./workflows").Return(workflowWithReferencesFs(t, "old-skill", "go-test"), nil)
+
+	config := configWithStandards("file://./standards")
+	config.AI = configWithWorkflows("file://./workflows").AI
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
+
+	err := action.Run()
+
+	require.ErrorIs(t, err, skillAPI.ErrSkillNotFound)
+	mockStandardRepo.AssertNotCalled(t, "RemoveAll")
+	mockInstructionRepo.AssertNotCalled(t, "RemoveAll")
+	mockSkillRepo.AssertNotCalled(t, "RemoveAll")
+	mockSkillRepo.AssertNotCalled(t, "GetSkillByName", mock.Anything)
+	mockWorkflowRepo.AssertNotCalled(t, "RemoveAllWorkflows")
+	mockMcpRepo.AssertNotCalled(t, "RemoveAll")
+	mockToolRepo.AssertNotCalled(t, "RemoveAll")
+}
+
+func invokingWorkflowFs(t *testing.T, invocations map[string]string) afero.Fs {
 	t.Helper()
 
 	fs := afero.NewMemMapFs()
 
-	rulebookMetadata := `ai:
+	for id, invokedId := range invocations {
+		workflow := `metadata:
+  id: ` + id + `
+  name: ` + id + `
+  description: Test description
+  version: 1.0.0
+steps:
+  - id: invoke
+    name: Invoke
+    description: Runs another workflow
+    instructions:
+      - Run the invoked workflow
+    workflow:
+      workflowId: ` + invokedId + `
+`
+		require.NoError(t, afero.WriteFile(fs, id+".yaml", []byte(workflow), 0644))
+	}
+
+	return fs
+}
+
+func TestUpdateActionRun_WhenWorkflowInvocationsFormCycle_ThenReturnsErrorAndRemovesNothing(t *testing.T) {
+	t.Parallel()
+
+	mockResolver := sourceAPI.NewMockResolver(t)
+	mockInstructionRepo := instructionAPI.NewMockRepository(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockStandardRepo := standardAPI.NewMockRepository(t)
+
+	mockResolver.EXPECT().Resolve("file://./workflows").Return(invokingWorkflowFs(t, map[string]string{"release": "changelog", "changelog": "release"}), nil)
+
+	config := configWithWorkflows("file://./workflows")
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
+
+	err := action.Run()
+
+	require.ErrorIs(t, err, workflowAPI.ErrWorkflowInvocationCycle)
+	assert.Contains(t, err.Error(), "validate workflow invocations")
+	mockWorkflowRepo.AssertNotCalled(t, "RemoveAllWorkflows")
+	mockSkillRepo.AssertNotCalled(t, "RemoveAll")
+}
+
+func TestUpdateActionRun_WhenWorkflowReferencesMissingTool_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockResolver := sourceAPI.NewMockResolver(t)
+	mockInstructionRepo := instructionAPI.NewMockRepository(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockStandardRepo := standardAPI.NewMockRepository(t)
+
+	mockResolver.EXPECT().Resolve("file://./skills").Return(validSkillFs(t, "test-skill", "Test skill", "# Instructions"), nil)
+	mockResolver.EXPECT().Resolve("file://./workflows").Return(workflowWithReferencesFs(t, "test-skill", "missing-tool"), nil)
+
+	config := configWithWorkflows("file://./workflows")
+	config.AI.Skill = &skillAPI.Config{Sources: []skillAPI.SourceConfig{{URI: "file://./skills"}}}
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
+
+	err := action.Run()
+
+	require.ErrorIs(t, err, toolAPI.ErrToolNotFound)
+}
+
+func validRulebookFs(t *testing.T) afero.Fs {
+	t.Helper()
+
+	fs := afero.NewBasePathFs(afero.NewMemMapFs(), "/")
+
+	rulebookMetadata := `name: test-rulebook
+version: 1.0.0
+description: Rulebook used in tests
+maintainers:
+  - name: Platform Team
+license: MIT
+ai:
   instruction:
     sources:
       - uri: rulebook://ai/instructions
@@ -785,6 +1172,7 @@ func TestUpdateActionRun_WhenRulebookConfigured_ThenMergesAllContent(t *testing.
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	fs := validRulebookFs(t)
@@ -799,10 +1187,61 @@ func TestUpdateActionRun_WhenRulebookConfigured_ThenMergesAllContent(t *testing.
 	mockWorkflowRepo.EXPECT().RemoveAllWorkflows().Return(nil)
 	mockWorkflowRepo.EXPECT().AddWorkflow(mock.AnythingOfType("workflow.Workflow")).Return(nil)
 	mockMcpRepo.EXPECT().RemoveAll().Return(nil)
+	mockToolRepo.EXPECT().RemoveAll().Return(nil)
+	mockMcpRepo.EXPECT().AddMCPServer(mock.AnythingOfType("mcp.MCPServer")).Return(nil)
+
+	config := configWithRulebook("file://./rulebook")
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
+
+	err := action.Run()
+
+	require.NoError(t, err)
+}
+
+func TestUpdateActionRun_WhenRulebookConfigured_ThenStoresProvenance(t *testing.T) {
+	t.Setenv("BRIEFKIT_BINARY_PATH", "/test/bin/projectkit")
+
+	mockResolver := sourceAPI.NewMockResolver(t)
+	mockInstructionRepo := instructionAPI.NewMockRepository(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockStandardRepo := standardAPI.NewMockRepository(t)
+
+	mockResolver.EXPECT().Resolve("file://./rulebook").Return(validRulebookFs(t), nil)
+
+	expectedProvenance := func(path string) sourceAPI.Provenance {
+		return sourceAPI.Provenance{
+			SourceURI:       "file://./rulebook",
+			RulebookName:    "test-rulebook",
+			RulebookVersion: "1.0.0",
+			Path:            path,
+		}
+	}
+
+	mockStandardRepo.EXPECT().RemoveAll().Return(nil)
+	mockStandardRepo.EXPECT().AddStandard(mock.MatchedBy(func(standard standardAPI.Standard) bool {
+		return assert.Equal(t, expectedProvenance("docs/standards/test-standard.yaml"), *standard.Provenance)
+	})).Return(nil)
+	mockInstructionRepo.EXPECT().RemoveAll().Return(nil)
+	mockInstructionRepo.EXPECT().AddInstructions(mock.MatchedBy(func(instructions instructionAPI.Instructions) bool {
+		return assert.Equal(t, []sourceAPI.Provenance{expectedProvenance("ai/instructions/01-coding.yaml")}, instructions.Provenance)
+	})).Return(nil)
+	mockSkillRepo.EXPECT().RemoveAll().Return(nil)
+	mockSkillRepo.EXPECT().AddSkill(mock.MatchedBy(func(skill skillAPI.Skill) bool {
+		return assert.Equal(t, expectedProvenance("ai/skills/test-skill"), *skill.Provenance)
+	})).Return(nil)
+	mockWorkflowRepo.EXPECT().RemoveAllWorkflows().Return(nil)
+	mockWorkflowRepo.EXPECT().AddWorkflow(mock.MatchedBy(func(workflow workflowAPI.Workflow) bool {
+		return assert.Equal(t, expectedProvenance("ai/workflows/test-workflow.yaml"), *workflow.Provenance)
+	})).Return(nil)
+	mockMcpRepo.EXPECT().RemoveAll().Return(nil)
+	mockToolRepo.EXPECT().RemoveAll().Return(nil)
 	mockMcpRepo.EXPECT().AddMCPServer(mock.AnythingOfType("mcp.MCPServer")).Return(nil)
 
 	config := configWithRulebook("file://./rulebook")
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
@@ -817,6 +1256,7 @@ func TestUpdateActionRun_WhenEmptyConfig_ThenAddsProjectkitMCPServer(t *testing.
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	mockStandardRepo.EXPECT().RemoveAll().Return(nil)
@@ -824,6 +1264,7 @@ func TestUpdateActionRun_WhenEmptyConfig_ThenAddsProjectkitMCPServer(t *testing.
 	mockSkillRepo.EXPECT().RemoveAll().Return(nil)
 	mockWorkflowRepo.EXPECT().RemoveAllWorkflows().Return(nil)
 	mockMcpRepo.EXPECT().RemoveAll().Return(nil)
+	mockToolRepo.EXPECT().RemoveAll().Return(nil)
 	mockMcpRepo.EXPECT().AddMCPServer(mock.MatchedBy(func(server mcpAPI.MCPServer) bool {
 		if server.Name != "projectkit" {
 			return false
@@ -844,9 +1285,140 @@ func TestUpdateActionRun_WhenEmptyConfig_ThenAddsProjectkitMCPServer(t *testing.
 	})).Return(nil)
 
 	config := projectAPI.Config{}
-	action := NewUpdateAction(config, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	action := NewUpdateAction(config, afero.NewMemMapFs(), false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	err := action.Run()
 
 	require.NoError(t, err)
 }
+
+func TestUpdateActionRun_WhenSourcesResolved_ThenWritesLock(t *testing.T) {
+	t.Setenv("BRIEFKIT_BINARY_PATH", "/test/bin/projectkit")
+
+	mockResolver := sourceAPI.NewMockResolver(t)
+	mockInstructionRepo := instructionAPI.NewMockRepository(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockStandardRepo := standardAPI.NewMockRepository(t)
+
+	fs := validInstructionFs(t, "test-category", []string{"Rule one"})
+	mockResolver.EXPECT().Resolve("file://./instructions").Return(fs, nil)
+
+	mockStandardRepo.EXPECT().RemoveAll().Return(nil)
+	mockInstructionRepo.EXPECT().RemoveAll().Return(nil)
+	mockInstructionRepo.EXPECT().AddInstructions(mock.AnythingOfType("instruction.Instructions")).Return(nil)
+	mockSkillRepo.EXPECT().RemoveAll().Return(nil)
+	mockWorkflowRepo.EXPECT().RemoveAllWorkflows().Return(nil)
+	mockMcpRepo.EXPECT().RemoveAll().Return(nil)
+	mockToolRepo.EXPECT().RemoveAll().Return(nil)
+	mockMcpRepo.EXPECT().AddMCPServer(mock.AnythingOfType("mcp.MCPServer")).Return(nil)
+
+	projectFs := afero.NewMemMapFs()
+	config := configWithInstructions("file://./instructions")
+	action := NewUpdateAction(config, projectFs, false, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
+
+	err := action.Run()
+
+	require.NoError(t, err)
+	lock, err := source.LoadLock(projectFs)
+	require.NoError(t, err)
+	require.Len(t, lock.Sources, 1)
+	assert.Equal(t, "file://./instructions", lock.Sources[0].URI)
+	assert.Contains(t, lock.Sources[0].ContentHash, "sha256:")
+	assert.Equal(t, sourceAPI.Revision(lock.Sources[0].ContentHash), lock.Sources[0].Revision)
+}
+
+func TestUpdateActionRun_WhenLockedAndSourceMatches_ThenKeepsLock(t *testing.T) {
+	t.Setenv("BRIEFKIT_BINARY_PATH", "/test/bin/projectkit")
+
+	mockResolver := sourceAPI.NewMockResolver(t)
+	mockInstructionRepo := instructionAPI.NewMockRepository(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockStandardRepo := standardAPI.NewMockRepository(t)
+
+	fs := validInstructionFs(t, "test-category", []string{"Rule one"})
+	contentHash, err := source.HashFs(fs)
+	require.NoError(t, err)
+	mockResolver.EXPECT().Resolve("file://./instructions").Return(fs, nil)
+
+	mockStandardRepo.EXPECT().RemoveAll().Return(nil)
+	mockInstructionRepo.EXPECT().RemoveAll().Return(nil)
+	mockInstructionRepo.EXPECT().AddInstructions(mock.AnythingOfType("instruction.Instructions")).Return(nil)
+	mockSkillRepo.EXPECT().RemoveAll().Return(nil)
+	mockWorkflowRepo.EXPECT().RemoveAllWorkflows().Return(nil)
+	mockMcpRepo.EXPECT().RemoveAll().Return(nil)
+	mockToolRepo.EXPECT().RemoveAll().Return(nil)
+	mockMcpRepo.EXPECT().AddMCPServer(mock.AnythingOfType("mcp.MCPServer")).Return(nil)
+
+	projectFs := afero.NewMemMapFs()
+	lock := sourceAPI.Lock{Sources: []sourceAPI.LockedSource{{
+		URI:         "file://./instructions",
+		Revision:    sourceAPI.Revision(contentHash),
+		ContentHash: contentHash,
+	}}}
+	require.NoError(t, source.SaveLock(projectFs, lock))
+	lockContent, err := afero.ReadFile(projectFs, sourceAPI.LockFileName)
+	require.NoError(t, err)
+
+	config := configWithInstructions("file://./instructions")
+	action := NewUpdateAction(config, projectFs, true, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
+
+	err = action.Run()
+
+	require.NoError(t, err)
+	currentContent, err := afero.ReadFile(projectFs, sourceAPI.LockFileName)
+	require.NoError(t, err)
+	assert.Equal(t, lockContent, currentContent)
+}
+
+func TestUpdateActionRun_WhenLockedAndSourceChanged_ThenReturnsError(t *testing.T) {
+	mockResolver := sourceAPI.NewMockResolver(t)
+	mockInstructionRepo := instructionAPI.NewMockRepository(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockStandardRepo := standardAPI.NewMockRepository(t)
+
+	fs := validInstructionFs(t, "test-category", []string{"Changed rule"})
+	mockResolver.EXPECT().Resolve("file://./instructions").Return(fs, nil)
+
+	projectFs := afero.NewMemMapFs()
+	lock := sourceAPI.Lock{Sources: []sourceAPI.LockedSource{{
+		URI:         "file://./instructions",
+		Revision:    "sha256:previous",
+		ContentHash: "sha256:previous",
+	}}}
+	require.NoError(t, source.SaveLock(projectFs, lock))
+
+	config := configWithInstructions("file://./instructions")
+	action := NewUpdateAction(config, projectFs, true, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
+
+	err := action.Run()
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, sourceAPI.ErrLockMismatch)
+}
+
+func TestUpdateActionRun_WhenLockedWithoutLockFile_ThenReturnsError(t *testing.T) {
+	mockResolver := sourceAPI.NewMockResolver(t)
+	mockInstructionRepo := instructionAPI.NewMockRepository(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockStandardRepo := standardAPI.NewMockRepository(t)
+
+	config := configWithInstructions("file://./instructions")
+	action := NewUpdateAction(config, afero.NewMemMapFs(), true, mockResolver, mockInstructionRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
+
+	err := action.Run()
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, sourceAPI.ErrLockNotFound)
+}
@@ -7,6 +7,8 @@ import (
 	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	mcpAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
 	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
 	projectAPI "github.com/orbiqd/orbiqd-projectkit/pkg/project"
 )
 
@@ -19,6 +21,8 @@ func (cmd *AgentRenderCmd) Run(
 	skillRepository skillAPI.Repository,
 	agentRegistry agentAPI.Registry,
 	mcpRepository mcpAPI.Repository,
+	toolRepository toolAPI.Repository,
+	workflowRepository workflowAPI.Repository,
 ) error {
-	return action.NewRenderAgentAction(gitFs, *config, agentRegistry, skillRepository, instructionRepository, mcpRepository).Run()
+	return action.NewRenderAgentAction(gitFs, *config, agentRegistry, skillRepository, instructionRepository, mcpRepository, toolRepository, workflowRepository).Run()
 }
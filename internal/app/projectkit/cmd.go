@@ -1,13 +1,18 @@
 package projectkit
 
-import "github.com/orbiqd/orbiqd-projectkit/internal/pkg/log"
+import (
+	"github.com/orbiqd/orbiqd-projectkit/internal/pkg/log"
+	"github.com/orbiqd/orbiqd-projectkit/internal/pkg/source"
+)
 
 type Cmd struct {
-	Log log.Config `embed:"true" prefix:"log-"`
+	Log    log.Config    `embed:"true" prefix:"log-"`
+	Source source.Config `embed:"true"`
 
-	MCP    MCPCmd    `cmd:"mcp" help:"MCP-related commands."`
-	Update UpdateCmd `cmd:"update" help:"Update projectkit repositories from config."`
-	Render RenderCmd `cmd:"render" help:"Render all configurations."`
-	Agent  AgentCmd  `cmd:"agent" help:"Agent-related commands."`
-	Doc    DocCmd    `cmd:"doc" help:"Documentation-related commands."`
+	MCP      MCPCmd      `cmd:"mcp" help:"MCP-related commands."`
+	Update   UpdateCmd   `cmd:"update" help:"Update projectkit repositories from config."`
+	Render   RenderCmd   `cmd:"render" help:"Render all configurations."`
+	Agent    AgentCmd    `cmd:"agent" help:"Agent-related commands."`
+	Doc      DocCmd      `cmd:"doc" help:"Documentation-related commands."`
+	Workflow WorkflowCmd `cmd:"workflow" help:"Workflow-related commands."`
 }
@@ -2,15 +2,18 @@ package projectkit
 
 import (
 	"testing"
+	"time"
 
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	agentAPI "github.com/orbiqd/orbiqd-projectkit/pkg/agent"
+	aiAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai"
 	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	mcpAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
 	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
 	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
 	docAPI "github.com/orbiqd/orbiqd-projectkit/pkg/doc"
 	standardAPI "github.com/orbiqd/orbiqd-projectkit/pkg/doc/standard"
@@ -26,6 +29,7 @@ func TestUpdateCmdRun_WhenEmptyConfig_ThenReturnsNoError(t *testing.T) {
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 
 	mockStandardRepo.EXPECT().RemoveAll().Return(nil)
@@ -34,11 +38,12 @@ func TestUpdateCmdRun_WhenEmptyConfig_ThenReturnsNoError(t *testing.T) {
 	mockWorkflowRepo.EXPECT().RemoveAllWorkflows().Return(nil)
 	mockMcpRepo.EXPECT().RemoveAll().Return(nil)
 	mockMcpRepo.EXPECT().AddMCPServer(mock.AnythingOfType("mcp.MCPServer")).Return(nil)
+	mockToolRepo.EXPECT().RemoveAll().Return(nil)
 
 	config := &projectAPI.Config{}
 	cmd := UpdateCmd{}
 
-	err := cmd.Run(config, mockResolver, mockInstRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockStandardRepo)
+	err := cmd.Run(config, afero.NewMemMapFs(), mockResolver, mockInstRepo, mockSkillRepo, mockWorkflowRepo, mockMcpRepo, mockToolRepo, mockStandardRepo)
 
 	require.NoError(t, err)
 }
@@ -48,12 +53,14 @@ func TestAgentRenderCmdRun_WhenNoAgents_ThenReturnsNoError(t *testing.T) {
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockInstRepo := instructionAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	gitFs := afero.NewMemMapFs()
 
 	config := &projectAPI.Config{}
 	cmd := AgentRenderCmd{}
 
-	err := cmd.Run(gitFs, config, mockInstRepo, mockSkillRepo, mockRegistry, mockMcpRepo)
+	err := cmd.Run(gitFs, config, mockInstRepo, mockSkillRepo, mockRegistry, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
 
 	require.NoError(t, err)
 }
@@ -83,6 +90,8 @@ func TestRenderCmdRun_WhenEmptyConfig_ThenReturnsNoError(t *testing.T) {
 	mockSkillRepo := skillAPI.NewMockRepository(t)
 	mockInstRepo := instructionAPI.NewMockRepository(t)
 	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
 	mockStandardRepo := standardAPI.NewMockRepository(t)
 	gitFs := afero.NewMemMapFs()
 	projectFs := afero.NewMemMapFs()
@@ -98,7 +107,150 @@ func TestRenderCmdRun_WhenEmptyConfig_ThenReturnsNoError(t *testing.T) {
 	}
 	cmd := RenderCmd{}
 
-	err := cmd.Run(gitFs, config, mockInstRepo, mockSkillRepo, mockRegistry, projectFs, mockStandardRepo, mockMcpRepo)
+	err := cmd.Run(gitFs, config, mockInstRepo, mockSkillRepo, mockRegistry, projectFs, mockStandardRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
+
+	require.NoError(t, err)
+}
+
+func TestRenderCmdRun_WhenWorkflowRenderConfigured_ThenRendersWorkflows(t *testing.T) {
+	mockRegistry := agentAPI.NewMockRegistry(t)
+	mockSkillRepo := skillAPI.NewMockRepository(t)
+	mockInstRepo := instructionAPI.NewMockRepository(t)
+	mockMcpRepo := mcpAPI.NewMockRepository(t)
+	mockToolRepo := toolAPI.NewMockRepository(t)
+	mockWorkflowRepo := workflowAPI.NewMockRepository(t)
+	mockStandardRepo := standardAPI.NewMockRepository(t)
+	gitFs := afero.NewMemMapFs()
+	projectFs := afero.NewMemMapFs()
+
+	mockStandardRepo.EXPECT().GetAll().Return([]standardAPI.Standard{}, nil)
+	mockWorkflowRepo.EXPECT().GetAllWorkflows().Return([]workflowAPI.Workflow{}, nil)
+
+	config := &projectAPI.Config{
+		AI: &aiAPI.Config{
+			Workflows: &workflowAPI.Config{
+				Render: []workflowAPI.RenderConfig{{Format: "mermaid", Destination: "docs/workflows"}},
+			},
+		},
+		Docs: &docAPI.Config{
+			Standard: &standardAPI.Config{},
+		},
+	}
+	cmd := RenderCmd{}
+
+	err := cmd.Run(gitFs, config, mockInstRepo, mockSkillRepo, mockRegistry, projectFs, mockStandardRepo, mockMcpRepo, mockToolRepo, mockWorkflowRepo)
+
+	require.NoError(t, err)
+
+	exists, _ := afero.DirExists(projectFs, "docs/workflows")
+	require.True(t, exists)
+}
+
+func TestWorkflowRenderCmdRun_WhenNoRenderConfig_ThenReturnsNoError(t *testing.T) {
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetAllWorkflows().Return([]workflowAPI.Workflow{}, nil)
+
+	cmd := WorkflowRenderCmd{}
+
+	err := cmd.Run(&projectAPI.Config{}, afero.NewMemMapFs(), mockRepository)
+
+	require.NoError(t, err)
+}
+
+func TestWorkflowApproveCmdRun_WhenAwaitingApproval_ThenApproves(t *testing.T) {
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().Approve(mock.Anything, workflowAPI.ExecutionId("exec-1")).Return(&workflowAPI.Execution{Id: "exec-1"}, nil)
+
+	cmd := WorkflowApproveCmd{ExecutionId: "exec-1"}
+
+	err := cmd.Run(mockService)
+
+	require.NoError(t, err)
+}
+
+func TestWorkflowRejectCmdRun_WhenAwaitingApproval_ThenRejectsWithReason(t *testing.T) {
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().Reject(mock.Anything, workflowAPI.ExecutionId("exec-1"), "Too risky.").Return(&workflowAPI.Execution{Id: "exec-1"}, nil)
+
+	cmd := WorkflowRejectCmd{ExecutionId: "exec-1", Reason: "Too risky."}
+
+	err := cmd.Run(mockService)
+
+	require.NoError(t, err)
+}
+
+func TestWorkflowMigrateCmdRun_WhenStepsMapped_ThenMigratesWithMapping(t *testing.T) {
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().Migrate(mock.Anything, workflowAPI.ExecutionId("exec-1"), map[string]string{"prepare": "collect"}).Return(&workflowAPI.Execution{Id: "exec-1"}, nil)
+
+	cmd := WorkflowMigrateCmd{ExecutionId: "exec-1", Step: map[string]string{"prepare": "collect"}}
+
+	err := cmd.Run(mockService)
+
+	require.NoError(t, err)
+}
+
+func TestWorkflowGCCmdRun_WhenRetentionConfigured_ThenRemovesExpiredExecutions(t *testing.T) {
+	completedAt := time.Now().Add(-48 * time.Hour)
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetAllExecutions().Return([]workflowAPI.Execution{
+		{Id: "exec-1", WorkflowId: "release", Status: workflowAPI.ExecutionStatusCompleted, CompletedAt: &completedAt},
+	}, nil)
+	mockRepository.EXPECT().RemoveExecution(workflowAPI.ExecutionId("exec-1")).Return(nil)
+
+	config := &projectAPI.Config{AI: &aiAPI.Config{Workflows: &workflowAPI.Config{Retention: &workflowAPI.RetentionConfig{MaxAge: "24h"}}}}
+	cmd := WorkflowGCCmd{}
+
+	err := cmd.Run(config, mockRepository)
+
+	require.NoError(t, err)
+}
+
+func TestWorkflowHistoryCmdRun_WhenExecutionExists_ThenShowsHistory(t *testing.T) {
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().History(workflowAPI.ExecutionId("exec-1")).Return([]workflowAPI.Event{}, nil)
+
+	cmd := WorkflowHistoryCmd{ExecutionId: "exec-1"}
+
+	err := cmd.Run(mockService)
+
+	require.NoError(t, err)
+}
+
+func TestWorkflowListCmdRun_WhenWorkflowsStored_ThenLists(t *testing.T) {
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetAllWorkflows().Return([]workflowAPI.Workflow{}, nil)
+
+	cmd := WorkflowListCmd{JSON: true}
+
+	err := cmd.Run(mockRepository)
+
+	require.NoError(t, err)
+}
+
+func TestWorkflowExecutionsCmdRun_WhenExecutionsStored_ThenLists(t *testing.T) {
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetAllExecutions().Return([]workflowAPI.Execution{}, nil)
+
+	cmd := WorkflowExecutionsCmd{}
+
+	err := cmd.Run(mockRepository)
+
+	require.NoError(t, err)
+}
+
+func TestWorkflowAbortCmdRun_WhenExecutionActive_ThenAborts(t *testing.T) {
+	mockService := workflowAPI.NewMockService(t)
+	mockService.EXPECT().Abort(mock.Anything, workflowAPI.ExecutionId("exec-1")).Return(&workflowAPI.Execution{Id: "exec-1", WorkflowId: "release", WorkflowVersion: "1.0.0", StepId: "prepare"}, nil)
+	mockRepository := workflowAPI.NewMockRepository(t)
+	mockRepository.EXPECT().GetWorkflowVersion(workflowAPI.WorkflowId("release"), "1.0.0").Return(&workflowAPI.Workflow{
+		Metadata: workflowAPI.Metadata{ID: "release", Version: "1.0.0"},
+		Steps:    []workflowAPI.Step{{ID: "prepare"}},
+	}, nil)
+
+	cmd := WorkflowAbortCmd{ExecutionId: "exec-1", JSON: true}
+
+	err := cmd.Run(mockRepository, mockService)
 
 	require.NoError(t, err)
 }
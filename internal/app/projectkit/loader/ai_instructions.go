@@ -23,7 +23,9 @@ func LoadAiInstructionsFromConfig(config instructionAPI.Config, sourceResolver s
 			return nil, fmt.Errorf("load instructions: %w", err)
 		}
 
-		instructions = append(instructions, instructionsSet...)
+		setInstructionsSourceUri(instructionsSet, instructionsUri)
+
+		instructions = append(instructions, filterInstructions(instructionsSet, instructionSourceConfig.Filter)...)
 	}
 
 	return instructions, nil
@@ -23,7 +23,9 @@ func LoadAiMCPServersFromConfig(config mcpAPI.Config, sourceResolver sourceAPI.R
 			return nil, fmt.Errorf("load mcp servers: %w", err)
 		}
 
-		servers = append(servers, serversSet...)
+		setMCPServersSourceUri(serversSet, mcpUri)
+
+		servers = append(servers, filterMCPServers(serversSet, mcpSourceConfig.Filter)...)
 	}
 
 	return servers, nil
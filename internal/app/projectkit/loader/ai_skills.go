@@ -24,7 +24,9 @@ func LoadAiSkillsFromConfig(config skillAPI.Config, sourceResolver sourceAPI.Res
 			return []skillAPI.Skill{}, fmt.Errorf("load skills: %w", err)
 		}
 
-		skills = append(skills, skillsSet...)
+		setSkillsSourceUri(skillsSet, skillsUri)
+
+		skills = append(skills, filterSkills(skillsSet, skillConfig.Filter)...)
 	}
 
 	return skills, nil
@@ -68,6 +68,20 @@ func TestLoadAiSkillsFromConfig(t *testing.T) {
 			},
 			wantSkillsLen: 2,
 		},
+		{
+			name: "WhenSourceExcludesSkill_ThenSkipsIt",
+			sources: []skillAPI.SourceConfig{
+				{URI: "file://./skills1"},
+				{URI: "file://./skills2", Filter: sourceAPI.Filter{Exclude: []sourceAPI.Selector{{Name: "skill-two"}}}},
+			},
+			mockSetup: func(m *sourceAPI.MockResolver) {
+				fs1 := validSkillFs(t, "skill-one", "First skill", "First instructions")
+				fs2 := validSkillFs(t, "skill-two", "Second skill", "Second instructions")
+				m.EXPECT().Resolve("file://./skills1").Return(fs1, nil)
+				m.EXPECT().Resolve("file://./skills2").Return(fs2, nil)
+			},
+			wantSkillsLen: 1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,32 @@
+package loader
+
+import (
+	"fmt"
+
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+)
+
+func LoadAiToolsFromConfig(config toolAPI.Config, sourceResolver sourceAPI.Resolver) ([]toolAPI.Tool, error) {
+	var tools []toolAPI.Tool
+
+	for _, toolSourceConfig := range config.Sources {
+		toolsUri := toolSourceConfig.URI
+		toolSource, err := sourceResolver.Resolve(toolsUri)
+		if err != nil {
+			return nil, fmt.Errorf("resolve: %s: %w", toolsUri, err)
+		}
+
+		toolLoader := toolAPI.NewLoader(toolSource)
+		toolsSet, err := toolLoader.Load()
+		if err != nil {
+			return nil, fmt.Errorf("load tools: %w", err)
+		}
+
+		setToolsSourceUri(toolsSet, toolsUri)
+
+		tools = append(tools, filterTools(toolsSet, toolSourceConfig.Filter)...)
+	}
+
+	return tools, nil
+}
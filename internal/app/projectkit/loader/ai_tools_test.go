@@ -0,0 +1,136 @@
+package loader
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+)
+
+func validToolFs(t *testing.T, id string, command string) afero.Fs {
+	t.Helper()
+
+	fs := afero.NewMemMapFs()
+
+	content := `metadata:
+  id: ` + id + `
+  name: ` + id + `
+  description: Tool used in tests.
+tool:
+  commands:
+    - ` + command + `
+`
+
+	require.NoError(t, afero.WriteFile(fs, id+".yaml", []byte(content), 0644))
+
+	return fs
+}
+
+func TestLoadAiToolsFromConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		sources      []toolAPI.SourceConfig
+		mockSetup    func(*sourceAPI.MockResolver)
+		wantToolsLen int
+	}{
+		{
+			name:         "WhenNoSources_ThenReturnsNilTools",
+			sources:      []toolAPI.SourceConfig{},
+			mockSetup:    func(m *sourceAPI.MockResolver) {},
+			wantToolsLen: 0,
+		},
+		{
+			name: "WhenMultipleSources_ThenReturnsCombinedTools",
+			sources: []toolAPI.SourceConfig{
+				{URI: "file://./tools1"},
+				{URI: "file://./tools2"},
+			},
+			mockSetup: func(m *sourceAPI.MockResolver) {
+				m.EXPECT().Resolve("file://./tools1").Return(validToolFs(t, "go-test", "go test"), nil)
+				m.EXPECT().Resolve("file://./tools2").Return(validToolFs(t, "go-vet", "go vet"), nil)
+			},
+			wantToolsLen: 2,
+		},
+		{
+			name: "WhenSourceExcludesTool_ThenSkipsIt",
+			sources: []toolAPI.SourceConfig{
+				{URI: "file://./tools1"},
+				{URI: "file://./tools2", Filter: sourceAPI.Filter{Exclude: []sourceAPI.Selector{{ID: "go-vet"}}}},
+			},
+			mockSetup: func(m *sourceAPI.MockResolver) {
+				m.EXPECT().Resolve("file://./tools1").Return(validToolFs(t, "go-test", "go test"), nil)
+				m.EXPECT().Resolve("file://./tools2").Return(validToolFs(t, "go-vet", "go vet"), nil)
+			},
+			wantToolsLen: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockResolver := sourceAPI.NewMockResolver(t)
+			tt.mockSetup(mockResolver)
+
+			config := toolAPI.Config{
+				Sources: tt.sources,
+			}
+
+			tools, err := LoadAiToolsFromConfig(config, mockResolver)
+
+			require.NoError(t, err)
+			assert.Len(t, tools, tt.wantToolsLen)
+			for index, tool := range tools {
+				assert.Equal(t, tt.sources[index].URI, tool.Provenance.SourceURI)
+			}
+		})
+	}
+}
+
+func TestLoadAiToolsFromConfig_WhenResolverFails_ThenReturnsResolveError(t *testing.T) {
+	t.Parallel()
+
+	mockResolver := sourceAPI.NewMockResolver(t)
+	resolveErr := errors.New("resolver failed")
+	mockResolver.EXPECT().Resolve("file://./tools").Return(nil, resolveErr)
+
+	config := toolAPI.Config{
+		Sources: []toolAPI.SourceConfig{
+			{URI: "file://./tools"},
+		},
+	}
+
+	tools, err := LoadAiToolsFromConfig(config, mockResolver)
+
+	require.Error(t, err)
+	assert.Nil(t, tools)
+	assert.Contains(t, err.Error(), "resolve: file://./tools")
+	assert.ErrorIs(t, err, resolveErr)
+}
+
+func TestLoadAiToolsFromConfig_WhenLoaderFails_ThenReturnsLoadToolsError(t *testing.T) {
+	t.Parallel()
+
+	mockResolver := sourceAPI.NewMockResolver(t)
+	mockResolver.EXPECT().Resolve("file://./tools").Return(afero.NewMemMapFs(), nil)
+
+	config := toolAPI.Config{
+		Sources: []toolAPI.SourceConfig{
+			{URI: "file://./tools"},
+		},
+	}
+
+	tools, err := LoadAiToolsFromConfig(config, mockResolver)
+
+	require.Error(t, err)
+	assert.Nil(t, tools)
+	assert.Contains(t, err.Error(), "load tools:")
+	assert.ErrorIs(t, err, toolAPI.ErrNoToolsFound)
+}
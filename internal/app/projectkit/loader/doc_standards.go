@@ -23,7 +23,9 @@ func LoadDocStandardsFromConfig(config standardAPI.Config, sourceResolver source
 			return []standardAPI.Standard{}, fmt.Errorf("load standard: %w", err)
 		}
 
-		standards = append(standards, loadedStandards...)
+		setStandardsSourceUri(loadedStandards, standardUri)
+
+		standards = append(standards, filterStandards(loadedStandards, standardSourceConfig.Filter)...)
 	}
 
 	return standards, nil
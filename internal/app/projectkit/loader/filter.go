@@ -0,0 +1,72 @@
+package loader
+
+import (
+	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
+	mcpAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
+	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+	standardAPI "github.com/orbiqd/orbiqd-projectkit/pkg/doc/standard"
+	rulebookAPI "github.com/orbiqd/orbiqd-projectkit/pkg/rulebook"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+)
+
+func filterItems[T any](items []T, filter sourceAPI.Filter, describe func(T) sourceAPI.Artifact) []T {
+	if len(filter.Include) == 0 && len(filter.Exclude) == 0 {
+		return items
+	}
+
+	var result []T
+	for _, item := range items {
+		if filter.Allows(describe(item)) {
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+func filterInstructions(instructions []instructionAPI.Instructions, filter sourceAPI.Filter) []instructionAPI.Instructions {
+	return filterItems(instructions, filter, func(item instructionAPI.Instructions) sourceAPI.Artifact {
+		return sourceAPI.Artifact{Category: string(item.Category)}
+	})
+}
+
+func filterSkills(skills []skillAPI.Skill, filter sourceAPI.Filter) []skillAPI.Skill {
+	return filterItems(skills, filter, func(item skillAPI.Skill) sourceAPI.Artifact {
+		return sourceAPI.Artifact{Name: string(item.Metadata.Name)}
+	})
+}
+
+func filterWorkflows(workflows []workflowAPI.Workflow, filter sourceAPI.Filter) []workflowAPI.Workflow {
+	return filterItems(workflows, filter, func(item workflowAPI.Workflow) sourceAPI.Artifact {
+		return sourceAPI.Artifact{Name: item.Metadata.Name, ID: string(item.Metadata.ID)}
+	})
+}
+
+func filterMCPServers(mcpServers []mcpAPI.MCPServer, filter sourceAPI.Filter) []mcpAPI.MCPServer {
+	return filterItems(mcpServers, filter, func(item mcpAPI.MCPServer) sourceAPI.Artifact {
+		return sourceAPI.Artifact{Name: item.Name}
+	})
+}
+
+func filterTools(tools []toolAPI.Tool, filter sourceAPI.Filter) []toolAPI.Tool {
+	return filterItems(tools, filter, func(item toolAPI.Tool) sourceAPI.Artifact {
+		return sourceAPI.Artifact{Name: item.Metadata.Name, ID: string(item.Metadata.ID)}
+	})
+}
+
+func filterStandards(standards []standardAPI.Standard, filter sourceAPI.Filter) []standardAPI.Standard {
+	return filterItems(standards, filter, func(item standardAPI.Standard) sourceAPI.Artifact {
+		return sourceAPI.Artifact{Name: item.Metadata.Name, ID: string(item.Metadata.Id), Tags: item.Metadata.Tags}
+	})
+}
+
+func filterRulebook(rulebook *rulebookAPI.Rulebook, filter sourceAPI.Filter) {
+	rulebook.AI.Instructions = filterInstructions(rulebook.AI.Instructions, filter)
+	rulebook.AI.Skills = filterSkills(rulebook.AI.Skills, filter)
+	rulebook.AI.Workflows = filterWorkflows(rulebook.AI.Workflows, filter)
+	rulebook.AI.MCPServers = filterMCPServers(rulebook.AI.MCPServers, filter)
+	rulebook.AI.Tools = filterTools(rulebook.AI.Tools, filter)
+	rulebook.Doc.Standards = filterStandards(rulebook.Doc.Standards, filter)
+}
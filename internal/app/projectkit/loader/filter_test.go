@@ -0,0 +1,63 @@
+package loader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
+	mcpAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+	standardAPI "github.com/orbiqd/orbiqd-projectkit/pkg/doc/standard"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+)
+
+func TestFilterItems_WhenFilterEmpty_ThenReturnsItemsUnchanged(t *testing.T) {
+	t.Parallel()
+
+	servers := []mcpAPI.MCPServer{{Name: "one"}, {Name: "two"}}
+
+	assert.Equal(t, servers, filterMCPServers(servers, sourceAPI.Filter{}))
+}
+
+func TestFilterInstructions_WhenCategoryIncluded_ThenKeepsOnlyCategory(t *testing.T) {
+	t.Parallel()
+
+	instructions := []instructionAPI.Instructions{
+		{Category: "coding", Rules: []instructionAPI.Rule{"rule one"}},
+		{Category: "testing", Rules: []instructionAPI.Rule{"rule two"}},
+	}
+	filter := sourceAPI.Filter{Include: []sourceAPI.Selector{{Category: "testing"}}}
+
+	result := filterInstructions(instructions, filter)
+
+	assert.Equal(t, instructions[1:], result)
+}
+
+func TestFilterWorkflows_WhenIdExcluded_ThenDropsWorkflow(t *testing.T) {
+	t.Parallel()
+
+	workflows := []workflowAPI.Workflow{
+		{Metadata: workflowAPI.Metadata{ID: "git-commit", Name: "Git Commit"}},
+		{Metadata: workflowAPI.Metadata{ID: "code-review", Name: "Code Review"}},
+	}
+	filter := sourceAPI.Filter{Exclude: []sourceAPI.Selector{{ID: "git-commit"}}}
+
+	result := filterWorkflows(workflows, filter)
+
+	assert.Equal(t, workflows[1:], result)
+}
+
+func TestFilterStandards_WhenTagExcluded_ThenDropsTaggedStandards(t *testing.T) {
+	t.Parallel()
+
+	standards := []standardAPI.Standard{
+		{Metadata: standardAPI.Metadata{Id: "error-handling", Tags: []string{"golang"}}},
+		{Metadata: standardAPI.Metadata{Id: "commit-messages", Tags: []string{"git"}}},
+	}
+	filter := sourceAPI.Filter{Exclude: []sourceAPI.Selector{{Tag: "golang"}}}
+
+	result := filterStandards(standards, filter)
+
+	assert.Equal(t, standards[1:], result)
+}
@@ -0,0 +1,70 @@
+package loader
+
+import (
+	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
+	mcpAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
+	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+	standardAPI "github.com/orbiqd/orbiqd-projectkit/pkg/doc/standard"
+	rulebookAPI "github.com/orbiqd/orbiqd-projectkit/pkg/rulebook"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+)
+
+func withSourceUri(provenance *sourceAPI.Provenance, uri string) *sourceAPI.Provenance {
+	result := sourceAPI.Provenance{}
+	if provenance != nil {
+		result = *provenance
+	}
+
+	result.SourceURI = uri
+
+	return &result
+}
+
+func setInstructionsSourceUri(instructions []instructionAPI.Instructions, uri string) {
+	for index := range instructions {
+		for provenanceIndex := range instructions[index].Provenance {
+			instructions[index].Provenance[provenanceIndex].SourceURI = uri
+		}
+	}
+}
+
+func setSkillsSourceUri(skills []skillAPI.Skill, uri string) {
+	for index := range skills {
+		skills[index].Provenance = withSourceUri(skills[index].Provenance, uri)
+	}
+}
+
+func setWorkflowsSourceUri(workflows []workflowAPI.Workflow, uri string) {
+	for index := range workflows {
+		workflows[index].Provenance = withSourceUri(workflows[index].Provenance, uri)
+	}
+}
+
+func setMCPServersSourceUri(mcpServers []mcpAPI.MCPServer, uri string) {
+	for index := range mcpServers {
+		mcpServers[index].Provenance = withSourceUri(mcpServers[index].Provenance, uri)
+	}
+}
+
+func setToolsSourceUri(tools []toolAPI.Tool, uri string) {
+	for index := range tools {
+		tools[index].Provenance = withSourceUri(tools[index].Provenance, uri)
+	}
+}
+
+func setStandardsSourceUri(standards []standardAPI.Standard, uri string) {
+	for index := range standards {
+		standards[index].Provenance = withSourceUri(standards[index].Provenance, uri)
+	}
+}
+
+func setRulebookSourceUri(rulebook *rulebookAPI.Rulebook, uri string) {
+	setInstructionsSourceUri(rulebook.AI.Instructions, uri)
+	setSkillsSourceUri(rulebook.AI.Skills, uri)
+	setWorkflowsSourceUri(rulebook.AI.Workflows, uri)
+	setMCPServersSourceUri(rulebook.AI.MCPServers, uri)
+	setToolsSourceUri(rulebook.AI.Tools, uri)
+	setStandardsSourceUri(rulebook.Doc.Standards, uri)
+}
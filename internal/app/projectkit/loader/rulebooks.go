@@ -3,36 +3,212 @@ package loader
 import (
 	"fmt"
 	"log/slog"
+	"net/url"
+	"path"
+	"strings"
 
 	rulebookAPI "github.com/orbiqd/orbiqd-projectkit/pkg/rulebook"
 	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
 )
 
+const localUriPrefix = "local://"
+
+// LoadRulebooksFromConfig loads the configured rulebooks together with their transitive dependencies.
+// Every rulebook is returned once, after all the rulebooks it depends on.
 func LoadRulebooksFromConfig(config rulebookAPI.Config, sourceResolver sourceAPI.Resolver) ([]rulebookAPI.Rulebook, error) {
-	var rulebooks []rulebookAPI.Rulebook
+	graph := newRulebookGraph(sourceResolver)
 
 	for _, rulebookSourceConfig := range config.Sources {
-		rulebookUri := rulebookSourceConfig.URI
+		if err := graph.visit(rulebookSourceConfig.URI, nil); err != nil {
+			return []rulebookAPI.Rulebook{}, err
+		}
+	}
 
-		rulebookSource, err := sourceResolver.Resolve(rulebookUri)
-		if err != nil {
-			return []rulebookAPI.Rulebook{}, fmt.Errorf("resolve rulebook uri: %w", err)
+	for _, rulebookSourceConfig := range config.Sources {
+		graph.filter(rulebookSourceConfig.URI, rulebookSourceConfig.Filter)
+	}
+
+	return graph.ordered, nil
+}
+
+// rulebookDependency is the edge through which a rulebook was reached from the rulebook depending on it.
+type rulebookDependency struct {
+	dependent  rulebookAPI.Rulebook
+	dependency rulebookAPI.Dependency
+}
+
+type rulebookNode struct {
+	uri      string
+	rulebook rulebookAPI.Rulebook
+}
+
+type rulebookGraph struct {
+	sourceResolver sourceAPI.Resolver
+
+	loaded   map[string]rulebookAPI.Rulebook
+	nodes    map[string]*rulebookNode
+	visiting []string
+	ordered  []rulebookAPI.Rulebook
+}
+
+func newRulebookGraph(sourceResolver sourceAPI.Resolver) *rulebookGraph {
+	return &rulebookGraph{
+		sourceResolver: sourceResolver,
+		loaded:         make(map[string]rulebookAPI.Rulebook),
+		nodes:          make(map[string]*rulebookNode),
+	}
+}
+
+// visit loads the rulebook behind uri and, depth first, every rulebook it depends on. The edge is
+// nil for rulebooks listed directly in the project configuration.
+func (graph *rulebookGraph) visit(uri string, edge *rulebookDependency) error {
+	rulebook, err := graph.load(uri)
+	if err != nil {
+		return err
+	}
+
+	node, found := graph.nodes[rulebook.Name]
+	if found && node.rulebook.Version != rulebook.Version {
+		return fmt.Errorf("rulebook %s: version %s from %s conflicts with version %s from %s: %w",
+			rulebook.Name, rulebook.Version, uri, node.rulebook.Version, node.uri, rulebookAPI.ErrIncompatibleVersion)
+	}
+
+	if edge != nil {
+		if err := checkDependency(*edge, rulebook); err != nil {
+			return err
 		}
+	}
+
+	for index, name := range graph.visiting {
+		if name == rulebook.Name {
+			cycle := append(append([]string{}, graph.visiting[index:]...), rulebook.Name)
+			return fmt.Errorf("rulebook %s: %w: %s", rulebook.Name, rulebookAPI.ErrDependencyCycle, strings.Join(cycle, " -> "))
+		}
+	}
 
-		rulebook, err := rulebookAPI.NewLoader(rulebookSource).Load()
+	if found {
+		return nil
+	}
+
+	node = &rulebookNode{uri: uri, rulebook: rulebook}
+	graph.nodes[rulebook.Name] = node
+
+	graph.visiting = append(graph.visiting, rulebook.Name)
+	for _, dependency := range rulebook.Dependencies {
+		dependencyUri, err := resolveDependencyUri(uri, dependency.URI)
 		if err != nil {
-			return []rulebookAPI.Rulebook{}, fmt.Errorf("load rulebook: %w", err)
+			return fmt.Errorf("rulebook %s dependency %s: %w", rulebook.Name, dependency.URI, err)
+		}
+
+		if err := graph.visit(dependencyUri, &rulebookDependency{dependent: rulebook, dependency: dependency}); err != nil {
+			return fmt.Errorf("rulebook %s dependency %s: %w", rulebook.Name, dependency.URI, err)
 		}
+	}
+	graph.visiting = graph.visiting[:len(graph.visiting)-1]
 
-		rulebooks = append(rulebooks, *rulebook)
+	graph.ordered = append(graph.ordered, rulebook)
+
+	return nil
+}
 
-		slog.Info("Loaded rulebook.",
-			slog.String("sourceUri", rulebookUri),
-			slog.Int("aiInstructionsCount", len(rulebook.AI.Instructions)),
-			slog.Int("aiSkillsCount", len(rulebook.AI.Skills)),
-			slog.Int("aiMCPServersCount", len(rulebook.AI.MCPServers)),
-		)
+func checkDependency(edge rulebookDependency, rulebook rulebookAPI.Rulebook) error {
+	satisfied, err := edge.dependency.SatisfiedBy(rulebook.Version)
+	if err != nil {
+		return fmt.Errorf("rulebook %s: %w", edge.dependent.Name, err)
 	}
+	if !satisfied {
+		return fmt.Errorf("rulebook %s requires %s %s, found %s: %w",
+			edge.dependent.Name, rulebook.Name, edge.dependency.Version, rulebook.Version, rulebookAPI.ErrIncompatibleVersion)
+	}
+
+	return nil
+}
+
+// resolveDependencyUri resolves a relative local:// dependency against the source of the rulebook declaring it,
+// so that local://../general next to a rulebook fetched from git points at the same repository. Other
+// dependency uris do not depend on their dependent and are returned unchanged.
+func resolveDependencyUri(dependentUri string, dependencyUri string) (string, error) {
+	dependencyPath, found := strings.CutPrefix(dependencyUri, localUriPrefix)
+	if !found || path.IsAbs(dependencyPath) {
+		return dependencyUri, nil
+	}
+
+	if dependentPath, found := strings.CutPrefix(dependentUri, localUriPrefix); found {
+		resolved := path.Join(dependentPath, dependencyPath)
+		if strings.HasPrefix(dependentPath, "./") && !path.IsAbs(resolved) && resolved != ".." && !strings.HasPrefix(resolved, "../") {
+			resolved = "./" + resolved
+		}
+
+		return localUriPrefix + resolved, nil
+	}
+
+	parsed, err := url.Parse(dependentUri)
+	if err != nil {
+		return "", fmt.Errorf("dependent uri %s parse: %w", dependentUri, err)
+	}
+
+	fragment, err := url.ParseQuery(parsed.Fragment)
+	if err != nil {
+		return "", fmt.Errorf("dependent uri %s fragment parse: %w", dependentUri, err)
+	}
+
+	subdir := path.Join(fragment.Get("subdir"), dependencyPath)
+	if subdir == ".." || strings.HasPrefix(subdir, "../") {
+		return "", fmt.Errorf("%s from %s: %w", dependencyUri, dependentUri, rulebookAPI.ErrDependencyOutsideSource)
+	}
+
+	fragment.Del("subdir")
+	parsed.Fragment = ""
+	parsed.RawFragment = ""
+
+	resolved := parsed.String() + "#subdir=" + subdir
+	if len(fragment) > 0 {
+		resolved += "&" + fragment.Encode()
+	}
+
+	return resolved, nil
+}
+
+// filter narrows down the artifacts of the rulebook loaded from uri. Rulebooks it depends on are left intact.
+func (graph *rulebookGraph) filter(uri string, filter sourceAPI.Filter) {
+	name := graph.loaded[uri].Name
+
+	for index := range graph.ordered {
+		if graph.ordered[index].Name == name {
+			filterRulebook(&graph.ordered[index], filter)
+		}
+	}
+}
+
+func (graph *rulebookGraph) load(uri string) (rulebookAPI.Rulebook, error) {
+	if rulebook, found := graph.loaded[uri]; found {
+		return rulebook, nil
+	}
+
+	rulebookSource, err := graph.sourceResolver.Resolve(uri)
+	if err != nil {
+		return rulebookAPI.Rulebook{}, fmt.Errorf("resolve rulebook uri: %w", err)
+	}
+
+	rulebook, err := rulebookAPI.NewLoader(rulebookSource).Load()
+	if err != nil {
+		return rulebookAPI.Rulebook{}, fmt.Errorf("load rulebook: %w", err)
+	}
+
+	setRulebookSourceUri(rulebook, uri)
+
+	graph.loaded[uri] = *rulebook
+
+	slog.Info("Loaded rulebook.",
+		slog.String("sourceUri", uri),
+		slog.String("rulebookName", rulebook.Name),
+		slog.String("rulebookVersion", rulebook.Version),
+		slog.Int("dependenciesCount", len(rulebook.Dependencies)),
+		slog.Int("aiInstructionsCount", len(rulebook.AI.Instructions)),
+		slog.Int("aiSkillsCount", len(rulebook.AI.Skills)),
+		slog.Int("aiMCPServersCount", len(rulebook.AI.MCPServers)),
+		slog.Int("aiToolsCount", len(rulebook.AI.Tools)),
+	)
 
-	return rulebooks, nil
+	return *rulebook, nil
 }
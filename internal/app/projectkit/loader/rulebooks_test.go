@@ -2,6 +2,8 @@ package loader
 
 import (
 	"errors"
+	"maps"
+	"slices"
 	"testing"
 
 	"github.com/spf13/afero"
@@ -17,7 +19,13 @@ func validRulebookFs(t *testing.T, category string, rules []string, skillName, s
 
 	fs := afero.NewMemMapFs()
 
-	rulebookContent := `ai:
+	rulebookContent := `name: ` + category + `
+version: 1.0.0
+description: Rulebook used in tests
+maintainers:
+  - name: Platform Team
+license: MIT
+ai:
   instruction:
     sources:
       - uri: rulebook://ai/instructions
@@ -78,6 +86,37 @@ examples:
 	return fs
 }
 
+func dependentRulebookFs(t *testing.T, name, version string, dependencies map[string]string) afero.Fs {
+	t.Helper()
+
+	fs := afero.NewMemMapFs()
+
+	rulebookContent := `name: ` + name + `
+version: ` + version + `
+description: Rulebook used in tests
+maintainers:
+  - name: Platform Team
+license: MIT
+`
+	if len(dependencies) > 0 {
+		rulebookContent += "dependencies:\n"
+		for _, uri := range slices.Sorted(maps.Keys(dependencies)) {
+			rulebookContent += "  - uri: " + uri + "\n    version: \"" + dependencies[uri] + "\"\n"
+		}
+	}
+	require.NoError(t, afero.WriteFile(fs, "rulebook.yaml", []byte(rulebookContent), 0644))
+
+	return fs
+}
+
+func rulebookNames(rulebooks []rulebookAPI.Rulebook) []string {
+	names := make([]string, 0, len(rulebooks))
+	for _, rulebook := range rulebooks {
+		names = append(names, rulebook.Name)
+	}
+	return names
+}
+
 func TestLoadRulebooksFromConfig(t *testing.T) {
 	t.Parallel()
 
@@ -136,7 +175,12 @@ func TestLoadRulebooksFromConfig(t *testing.T) {
 			require.NoError(t, err)
 			assert.Len(t, rulebooks, tt.wantRulebooksLen)
 
-			for _, rb := range rulebooks {
+			for index, rb := range rulebooks {
+				require.Len(t, rb.AI.Skills, 1)
+				assert.Equal(t, tt.sources[index].URI, rb.AI.Skills[0].Provenance.SourceURI)
+				assert.Equal(t, rb.Name, rb.AI.Skills[0].Provenance.RulebookName)
+				assert.NotEmpty(t, rb.Name)
+				assert.Equal(t, "1.0.0", rb.Version)
 				if len(rb.Doc.Standards) > 0 {
 					assert.Equal(t, "test-standard", rb.Doc.Standards[0].Metadata.Name)
 				}
@@ -145,6 +189,59 @@ func TestLoadRulebooksFromConfig(t *testing.T) {
 	}
 }
 
+func TestLoadRulebooksFromConfig_WhenSourceFiltered_ThenDropsFilteredArtifacts(t *testing.T) {
+	t.Parallel()
+
+	mockResolver := sourceAPI.NewMockResolver(t)
+	fs := validRulebookFs(t, "test-category", []string{"Rule one"}, "test-skill", "Test skill", "Test instructions")
+	mockResolver.EXPECT().Resolve("file://./rulebooks").Return(fs, nil)
+
+	config := rulebookAPI.Config{
+		Sources: []rulebookAPI.SourceConfig{
+			{
+				URI: "file://./rulebooks",
+				Filter: sourceAPI.Filter{
+					Exclude: []sourceAPI.Selector{
+						{Name: "test-skill"},
+						{Tag: "test"},
+					},
+				},
+			},
+		},
+	}
+
+	rulebooks, err := LoadRulebooksFromConfig(config, mockResolver)
+
+	require.NoError(t, err)
+	require.Len(t, rulebooks, 1)
+	assert.Empty(t, rulebooks[0].AI.Skills)
+	assert.Empty(t, rulebooks[0].Doc.Standards)
+	assert.Len(t, rulebooks[0].AI.Instructions, 1)
+}
+
+func TestLoadRulebooksFromConfig_WhenSourceFiltered_ThenKeepsDependenciesIntact(t *testing.T) {
+	t.Parallel()
+
+	mockResolver := sourceAPI.NewMockResolver(t)
+	mockResolver.EXPECT().Resolve("file://./app").Return(dependentRulebookFs(t, "app", "1.0.0", map[string]string{"file://./base": "^1.0.0"}), nil)
+	mockResolver.EXPECT().Resolve("file://./base").Return(validRulebookFs(t, "base", []string{"Base rule"}, "base-skill", "Base skill", "Base instructions"), nil)
+
+	config := rulebookAPI.Config{
+		Sources: []rulebookAPI.SourceConfig{
+			{
+				URI:    "file://./app",
+				Filter: sourceAPI.Filter{Exclude: []sourceAPI.Selector{{Name: "base-skill"}}},
+			},
+		},
+	}
+
+	rulebooks, err := LoadRulebooksFromConfig(config, mockResolver)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"base", "app"}, rulebookNames(rulebooks))
+	assert.Len(t, rulebooks[0].AI.Skills, 1)
+}
+
 func TestLoadRulebooksFromConfig_WhenResolverFails_ThenReturnsResolveError(t *testing.T) {
 	t.Parallel()
 
@@ -236,3 +333,161 @@ func TestLoadRulebooksFromConfig_WhenSecondSourceLoaderFails_ThenReturnsError(t
 	assert.Contains(t, err.Error(), "load rulebook")
 	assert.ErrorIs(t, err, rulebookAPI.ErrMissingMetadataFile)
 }
+
+func TestLoadRulebooksFromConfig_Dependencies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		sources   []string
+		mockSetup func(*sourceAPI.MockResolver)
+		wantNames []string
+		wantErr   error
+		errorMsg  string
+	}{
+		{
+			name:    "WhenTransitiveDependencies_ThenReturnsDependenciesFirst",
+			sources: []string{"local://golang"},
+			mockSetup: func(m *sourceAPI.MockResolver) {
+				m.EXPECT().Resolve("local://golang").Return(dependentRulebookFs(t, "golang", "1.0.0", map[string]string{"local://../general": "^1.0.0"}), nil)
+				m.EXPECT().Resolve("local://general").Return(dependentRulebookFs(t, "general", "1.2.0", map[string]string{"local://../base": ">=0.1.0"}), nil)
+				m.EXPECT().Resolve("local://base").Return(dependentRulebookFs(t, "base", "0.3.0", nil), nil)
+			},
+			wantNames: []string{"base", "general", "golang"},
+		},
+		{
+			name:    "WhenDiamondDependency_ThenLoadsSharedRulebookOnce",
+			sources: []string{"local://app", "local://general"},
+			mockSetup: func(m *sourceAPI.MockResolver) {
+				m.EXPECT().Resolve("local://app").Return(dependentRulebookFs(t, "app", "1.0.0", map[string]string{"local://../golang": "^1.0.0", "local://../python": "^1.0.0"}), nil)
+				m.EXPECT().Resolve("local://golang").Return(dependentRulebookFs(t, "golang", "1.0.0", map[string]string{"local://../general": "^1.0.0"}), nil)
+				m.EXPECT().Resolve("local://python").Return(dependentRulebookFs(t, "python", "1.0.0", map[string]string{"local://../general": "~1.2.0"}), nil)
+				m.EXPECT().Resolve("local://general").Return(dependentRulebookFs(t, "general", "1.2.3", nil), nil).Once()
+			},
+			wantNames: []string{"general", "golang", "python", "app"},
+		},
+		{
+			name:    "WhenSameRulebookFromDifferentUris_ThenDeduplicatesByName",
+			sources: []string{"local://golang", "local://./general"},
+			mockSetup: func(m *sourceAPI.MockResolver) {
+				m.EXPECT().Resolve("local://golang").Return(dependentRulebookFs(t, "golang", "1.0.0", map[string]string{"local://../general": "^1.0.0"}), nil)
+				m.EXPECT().Resolve("local://general").Return(dependentRulebookFs(t, "general", "1.0.0", nil), nil)
+				m.EXPECT().Resolve("local://./general").Return(dependentRulebookFs(t, "general", "1.0.0", nil), nil)
+			},
+			wantNames: []string{"general", "golang"},
+		},
+		{
+			name:    "WhenRelativeDependencyOfLocalRulebook_ThenResolvesAgainstRulebookDirectory",
+			sources: []string{"local://./rulebooks/golang"},
+			mockSetup: func(m *sourceAPI.MockResolver) {
+				m.EXPECT().Resolve("local://./rulebooks/golang").Return(dependentRulebookFs(t, "golang", "1.0.0", map[string]string{"local://../general": "^1.0.0"}), nil)
+				m.EXPECT().Resolve("local://./rulebooks/general").Return(dependentRulebookFs(t, "general", "1.0.0", nil), nil)
+			},
+			wantNames: []string{"general", "golang"},
+		},
+		{
+			name:    "WhenRelativeDependencyOfRemoteRulebook_ThenResolvesWithinSameSource",
+			sources: []string{"git+https://example.com/rulebooks.git?ref=v1.0.0#subdir=rulebooks/golang"},
+			mockSetup: func(m *sourceAPI.MockResolver) {
+				m.EXPECT().Resolve("git+https://example.com/rulebooks.git?ref=v1.0.0#subdir=rulebooks/golang").Return(dependentRulebookFs(t, "golang", "1.0.0", map[string]string{"local://../general": "^1.0.0"}), nil)
+				m.EXPECT().Resolve("git+https://example.com/rulebooks.git?ref=v1.0.0#subdir=rulebooks/general").Return(dependentRulebookFs(t, "general", "1.0.0", nil), nil)
+			},
+			wantNames: []string{"general", "golang"},
+		},
+		{
+			name:    "WhenAbsoluteLocalDependency_ThenKeepsUri",
+			sources: []string{"git+https://example.com/rulebooks.git#subdir=golang"},
+			mockSetup: func(m *sourceAPI.MockResolver) {
+				m.EXPECT().Resolve("git+https://example.com/rulebooks.git#subdir=golang").Return(dependentRulebookFs(t, "golang", "1.0.0", map[string]string{"local:///srv/general": "^1.0.0"}), nil)
+				m.EXPECT().Resolve("local:///srv/general").Return(dependentRulebookFs(t, "general", "1.0.0", nil), nil)
+			},
+			wantNames: []string{"general", "golang"},
+		},
+		{
+			name:    "WhenRelativeDependencyEscapesRemoteSource_ThenReturnsErrDependencyOutsideSource",
+			sources: []string{"git+https://example.com/rulebooks.git#subdir=golang"},
+			mockSetup: func(m *sourceAPI.MockResolver) {
+				m.EXPECT().Resolve("git+https://example.com/rulebooks.git#subdir=golang").Return(dependentRulebookFs(t, "golang", "1.0.0", map[string]string{"local://../../general": "^1.0.0"}), nil)
+			},
+			wantErr:  rulebookAPI.ErrDependencyOutsideSource,
+			errorMsg: "rulebook golang dependency local://../../general",
+		},
+		{
+			name:    "WhenCycle_ThenReturnsErrDependencyCycle",
+			sources: []string{"local://a"},
+			mockSetup: func(m *sourceAPI.MockResolver) {
+				m.EXPECT().Resolve("local://a").Return(dependentRulebookFs(t, "a", "1.0.0", map[string]string{"local://../b": "*"}), nil)
+				m.EXPECT().Resolve("local://b").Return(dependentRulebookFs(t, "b", "1.0.0", map[string]string{"local://../a": "*"}), nil)
+			},
+			wantErr:  rulebookAPI.ErrDependencyCycle,
+			errorMsg: "a -> b -> a",
+		},
+		{
+			name:    "WhenConstraintNotSatisfied_ThenReturnsErrIncompatibleVersion",
+			sources: []string{"local://golang"},
+			mockSetup: func(m *sourceAPI.MockResolver) {
+				m.EXPECT().Resolve("local://golang").Return(dependentRulebookFs(t, "golang", "1.0.0", map[string]string{"local://../general": "^2.0.0"}), nil)
+				m.EXPECT().Resolve("local://general").Return(dependentRulebookFs(t, "general", "1.4.0", nil), nil)
+			},
+			wantErr:  rulebookAPI.ErrIncompatibleVersion,
+			errorMsg: "golang requires general ^2.0.0, found 1.4.0",
+		},
+		{
+			name:    "WhenDiamondConstraintsIncompatible_ThenReturnsErrIncompatibleVersion",
+			sources: []string{"local://golang", "local://python"},
+			mockSetup: func(m *sourceAPI.MockResolver) {
+				m.EXPECT().Resolve("local://golang").Return(dependentRulebookFs(t, "golang", "1.0.0", map[string]string{"local://../general": "^1.0.0"}), nil)
+				m.EXPECT().Resolve("local://general").Return(dependentRulebookFs(t, "general", "1.4.0", nil), nil)
+				m.EXPECT().Resolve("local://python").Return(dependentRulebookFs(t, "python", "1.0.0", map[string]string{"local://../general": "<1.3.0"}), nil)
+			},
+			wantErr:  rulebookAPI.ErrIncompatibleVersion,
+			errorMsg: "python requires general <1.3.0, found 1.4.0",
+		},
+		{
+			name:    "WhenSameNameWithDifferentVersions_ThenReturnsErrIncompatibleVersion",
+			sources: []string{"local://general-v1", "local://general-v2"},
+			mockSetup: func(m *sourceAPI.MockResolver) {
+				m.EXPECT().Resolve("local://general-v1").Return(dependentRulebookFs(t, "general", "1.0.0", nil), nil)
+				m.EXPECT().Resolve("local://general-v2").Return(dependentRulebookFs(t, "general", "2.0.0", nil), nil)
+			},
+			wantErr:  rulebookAPI.ErrIncompatibleVersion,
+			errorMsg: "conflicts with version 1.0.0",
+		},
+		{
+			name:    "WhenDependencyResolveFails_ThenReturnsError",
+			sources: []string{"local://golang"},
+			mockSetup: func(m *sourceAPI.MockResolver) {
+				m.EXPECT().Resolve("local://golang").Return(dependentRulebookFs(t, "golang", "1.0.0", map[string]string{"local://../general": "^1.0.0"}), nil)
+				m.EXPECT().Resolve("local://general").Return(nil, sourceAPI.ErrUnsupportedScheme)
+			},
+			wantErr:  sourceAPI.ErrUnsupportedScheme,
+			errorMsg: "rulebook golang dependency local://../general",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockResolver := sourceAPI.NewMockResolver(t)
+			tt.mockSetup(mockResolver)
+
+			config := rulebookAPI.Config{}
+			for _, uri := range tt.sources {
+				config.Sources = append(config.Sources, rulebookAPI.SourceConfig{URI: uri})
+			}
+
+			rulebooks, err := LoadRulebooksFromConfig(config, mockResolver)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+				assert.Empty(t, rulebooks)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantNames, rulebookNames(rulebooks))
+		})
+	}
+}
@@ -24,7 +24,9 @@ func LoadWorkflowsFromConfig(config workflowAPI.Config, sourceResolver sourceAPI
 			return []workflowAPI.Workflow{}, fmt.Errorf("load workflows: %w", err)
 		}
 
-		workflows = append(workflows, workflowsSet...)
+		setWorkflowsSourceUri(workflowsSet, workflowsUri)
+
+		workflows = append(workflows, filterWorkflows(workflowsSet, workflowConfig.Filter)...)
 	}
 
 	return workflows, nil
@@ -0,0 +1,181 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	mcpgo "github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	standardAPI "github.com/orbiqd/orbiqd-projectkit/pkg/doc/standard"
+)
+
+const (
+	standardResourceURIPrefix = "projectkit://standards/"
+	standardResourceMIMEType  = "text/markdown"
+)
+
+// StandardTools exposes the doc standards of a standard repository to agents: every standard as a Markdown
+// resource, and a tool that searches the requirement rules of the standards.
+type StandardTools struct {
+	standardRepository standardAPI.Repository
+	renderer           standardAPI.Renderer
+}
+
+func NewStandardTools(standardRepository standardAPI.Repository, renderer standardAPI.Renderer) *StandardTools {
+	return &StandardTools{
+		standardRepository: standardRepository,
+		renderer:           renderer,
+	}
+}
+
+// ServerResources returns a resource for every standard in the repository. A standard is looked up and rendered
+// when it is read, so that its content follows the repository while the server runs.
+func (tools *StandardTools) ServerResources() ([]server.ServerResource, error) {
+	standards, err := tools.standardRepository.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("get all standards: %w", err)
+	}
+
+	resources := make([]server.ServerResource, 0, len(standards))
+	for _, standard := range standards {
+		uri := standardResourceURI(standard.Metadata.Id)
+
+		resources = append(resources, server.ServerResource{
+			Resource: mcpgo.NewResource(uri, standard.Metadata.Name,
+				mcpgo.WithResourceDescription(standard.Specification.Purpose),
+				mcpgo.WithMIMEType(standardResourceMIMEType),
+			),
+			Handler: func(_ context.Context, _ mcpgo.ReadResourceRequest) ([]mcpgo.ResourceContents, error) {
+				return tools.readStandard(uri)
+			},
+		})
+	}
+
+	return resources, nil
+}
+
+// ServerResourceTemplates returns the template of the standard resources, which serves the standards added to the
+// repository after the resources were listed.
+func (tools *StandardTools) ServerResourceTemplates() []server.ServerResourceTemplate {
+	return []server.ServerResourceTemplate{
+		{
+			Template: mcpgo.NewResourceTemplate(standardResourceURIPrefix+"{id}", "Doc standard",
+				mcpgo.WithTemplateDescription("A doc standard of the project rendered as Markdown. Find the standards with the standards_search tool."),
+				mcpgo.WithTemplateMIMEType(standardResourceMIMEType),
+			),
+			Handler: func(_ context.Context, request mcpgo.ReadResourceRequest) ([]mcpgo.ResourceContents, error) {
+				return tools.readStandard(request.Params.URI)
+			},
+		},
+	}
+}
+
+func (tools *StandardTools) readStandard(uri string) ([]mcpgo.ResourceContents, error) {
+	id := standardAPI.StandardId(strings.TrimPrefix(uri, standardResourceURIPrefix))
+
+	standards, err := tools.standardRepository.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("get all standards: %w", err)
+	}
+
+	index := slices.IndexFunc(standards, func(standard standardAPI.Standard) bool {
+		return standard.Metadata.Id == id
+	})
+	if index < 0 {
+		return nil, fmt.Errorf("standard %s: %w", id, standardAPI.ErrStandardNotFound)
+	}
+
+	content, err := tools.renderer.Render(standards[index])
+	if err != nil {
+		return nil, fmt.Errorf("render standard %s: %w", id, err)
+	}
+
+	return []mcpgo.ResourceContents{
+		mcpgo.TextResourceContents{URI: uri, MIMEType: standardResourceMIMEType, Text: string(content)},
+	}, nil
+}
+
+// ServerTools returns the standard tools together with their handlers.
+func (tools *StandardTools) ServerTools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Tool: mcpgo.NewTool("standards_search",
+				mcpgo.WithDescription("Search the doc standards of the project and return their requirement rules. All filters are optional and combined; the full standard can be read from the resource in the uri field."),
+				mcpgo.WithString("tag", mcpgo.Description("Only standards with this tag, e.g. logging.")),
+				mcpgo.WithString("language", mcpgo.Description("Only standards written in this language, as an ISO 639-1 code.")),
+				mcpgo.WithString("appliesTo", mcpgo.Description("Only standards that apply to this, matched case-insensitively against the appliesTo entries of their scope.")),
+				mcpgo.WithReadOnlyHintAnnotation(true),
+			),
+			Handler: tools.handleSearch,
+		},
+	}
+}
+
+type standardView struct {
+	ID      standardAPI.StandardId        `json:"id"`
+	Name    string                        `json:"name"`
+	Version string                        `json:"version"`
+	URI     string                        `json:"uri"`
+	Tags    []string                      `json:"tags"`
+	Rules   []standardAPI.RequirementRule `json:"rules"`
+}
+
+type standardSearchView struct {
+	Standards []standardView `json:"standards"`
+}
+
+func (tools *StandardTools) handleSearch(_ context.Context, request mcpgo.CallToolRequest) (*mcpgo.CallToolResult, error) {
+	tag := request.GetString("tag", "")
+	language := request.GetString("language", "")
+	appliesTo := request.GetString("appliesTo", "")
+
+	standards, err := tools.standardRepository.GetAll()
+	if err != nil {
+		return mcpgo.NewToolResultErrorFromErr("get all standards", err), nil
+	}
+
+	view := standardSearchView{Standards: []standardView{}}
+	for _, standard := range standards {
+		if !matchesStandard(standard, tag, language, appliesTo) {
+			continue
+		}
+
+		view.Standards = append(view.Standards, standardView{
+			ID:      standard.Metadata.Id,
+			Name:    standard.Metadata.Name,
+			Version: standard.Metadata.Version,
+			URI:     standardResourceURI(standard.Metadata.Id),
+			Tags:    standard.Metadata.Tags,
+			Rules:   standard.Requirements.Rules,
+		})
+	}
+
+	return mcpgo.NewToolResultStructuredOnly(view), nil
+}
+
+// matchesStandard reports whether the standard passes the given filters; an empty filter passes every standard.
+func matchesStandard(standard standardAPI.Standard, tag string, language string, appliesTo string) bool {
+	if tag != "" && !slices.Contains(standard.Metadata.Tags, tag) {
+		return false
+	}
+
+	if language != "" && !slices.ContainsFunc(standard.Metadata.Scope.Languages, func(candidate string) bool {
+		return strings.EqualFold(candidate, language)
+	}) {
+		return false
+	}
+
+	if appliesTo != "" && !slices.ContainsFunc(standard.Metadata.Scope.AppliesTo, func(candidate string) bool {
+		return strings.Contains(strings.ToLower(candidate), strings.ToLower(appliesTo))
+	}) {
+		return false
+	}
+
+	return true
+}
+
+func standardResourceURI(id standardAPI.StandardId) string {
+	return standardResourceURIPrefix + string(id)
+}
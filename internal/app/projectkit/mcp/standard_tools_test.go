@@ -0,0 +1,256 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	mcpgo "github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	standardAPI "github.com/orbiqd/orbiqd-projectkit/pkg/doc/standard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testStandards() []standardAPI.Standard {
+	return []standardAPI.Standard{
+		{
+			Metadata: standardAPI.Metadata{
+				Id:      "go-structured-logging",
+				Name:    "Go Structured Logging",
+				Version: "1.0.0",
+				Tags:    []string{"golang", "logging"},
+				Scope:   standardAPI.ScopeMetadata{Languages: []string{"en"}, AppliesTo: []string{"Go services", "CLI tools"}},
+			},
+			Specification: standardAPI.Specification{Purpose: "Consistent structured logs."},
+			Requirements: standardAPI.Requirements{Rules: []standardAPI.RequirementRule{
+				{Level: "must", Statement: "Use log/slog for logging.", Rationale: "One logger across the codebase."},
+			}},
+		},
+		{
+			Metadata: standardAPI.Metadata{
+				Id:      "commit-messages",
+				Name:    "Commit Messages",
+				Version: "2.1.0",
+				Tags:    []string{"git"},
+				Scope:   standardAPI.ScopeMetadata{Languages: []string{"pl"}},
+			},
+			Specification: standardAPI.Specification{Purpose: "Readable history."},
+			Requirements: standardAPI.Requirements{Rules: []standardAPI.RequirementRule{
+				{Level: "should", Statement: "Start the subject with a verb.", Rationale: "Subjects read as commands."},
+			}},
+		},
+	}
+}
+
+func searchStandards(t *testing.T, tools *StandardTools, arguments map[string]any) standardSearchView {
+	t.Helper()
+
+	request := mcpgo.CallToolRequest{}
+	request.Params.Name = "standards_search"
+	request.Params.Arguments = arguments
+
+	result, err := tools.ServerTools()[0].Handler(context.Background(), request)
+	require.NoError(t, err)
+	require.False(t, result.IsError, resultText(t, result))
+
+	var view standardSearchView
+	require.NoError(t, json.Unmarshal([]byte(resultText(t, result)), &view))
+
+	return view
+}
+
+// newStandardServer starts an MCP server publishing the standards the way the mcp command does.
+func newStandardServer(t *testing.T, tools *StandardTools) *server.MCPServer {
+	t.Helper()
+
+	mcpServer := server.NewMCPServer("projectkit", "1.0.0", server.WithResourceCapabilities(false, false))
+	resources, err := tools.ServerResources()
+	require.NoError(t, err)
+	mcpServer.AddResources(resources...)
+	mcpServer.AddResourceTemplates(tools.ServerResourceTemplates()...)
+
+	return mcpServer
+}
+
+// handleMessage sends a JSON-RPC request to the server and decodes its result.
+func handleMessage[T any](t *testing.T, mcpServer *server.MCPServer, method string, params any) T {
+	t.Helper()
+
+	message, err := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": 1, "method": method, "params": params})
+	require.NoError(t, err)
+
+	response, ok := mcpServer.HandleMessage(context.Background(), message).(mcpgo.JSONRPCResponse)
+	require.True(t, ok, "expected a successful response")
+
+	data, err := json.Marshal(response.Result)
+	require.NoError(t, err)
+
+	var result T
+	require.NoError(t, json.Unmarshal(data, &result))
+
+	return result
+}
+
+func readStandard(t *testing.T, tools *StandardTools, uri string) ([]mcpgo.ResourceContents, error) {
+	t.Helper()
+
+	templates := tools.ServerResourceTemplates()
+	require.Len(t, templates, 1)
+
+	request := mcpgo.ReadResourceRequest{}
+	request.Params.URI = uri
+
+	return templates[0].Handler(context.Background(), request)
+}
+
+func TestStandardTools_ServerResources_ThenListsEveryStandardAsMarkdown(t *testing.T) {
+	t.Parallel()
+
+	repository := standardAPI.NewMockRepository(t)
+	repository.EXPECT().GetAll().Return(testStandards(), nil)
+
+	mcpServer := newStandardServer(t, NewStandardTools(repository, standardAPI.NewMockRenderer(t)))
+	result := handleMessage[mcpgo.ListResourcesResult](t, mcpServer, "resources/list", map[string]any{})
+
+	require.Len(t, result.Resources, 2)
+	uris := []string{result.Resources[0].URI, result.Resources[1].URI}
+	assert.ElementsMatch(t, []string{"projectkit://standards/go-structured-logging", "projectkit://standards/commit-messages"}, uris)
+	for _, resource := range result.Resources {
+		assert.Equal(t, "text/markdown", resource.MIMEType)
+	}
+}
+
+func TestStandardTools_ServerResources_WhenRead_ThenRendersStandard(t *testing.T) {
+	t.Parallel()
+
+	repository := standardAPI.NewMockRepository(t)
+	repository.EXPECT().GetAll().Return(testStandards(), nil)
+	renderer := standardAPI.NewMockRenderer(t)
+	renderer.EXPECT().Render(testStandards()[0]).Return([]byte("# Go Structured Logging\n"), nil)
+
+	resources, err := NewStandardTools(repository, renderer).ServerResources()
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+	assert.Equal(t, "Go Structured Logging", resources[0].Resource.Name)
+
+	contents, err := resources[0].Handler(context.Background(), mcpgo.ReadResourceRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []mcpgo.ResourceContents{
+		mcpgo.TextResourceContents{URI: "projectkit://standards/go-structured-logging", MIMEType: "text/markdown", Text: "# Go Structured Logging\n"},
+	}, contents)
+}
+
+func TestStandardTools_ServerResources_WhenRepositoryFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	repository := standardAPI.NewMockRepository(t)
+	repository.EXPECT().GetAll().Return(nil, errors.New("disk failure"))
+
+	_, err := NewStandardTools(repository, nil).ServerResources()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "get all standards: disk failure")
+}
+
+func TestStandardTools_ServerResourceTemplates_ThenPublishesStandardsTemplateAsMarkdown(t *testing.T) {
+	t.Parallel()
+
+	templates := NewStandardTools(standardAPI.NewMockRepository(t), standardAPI.NewMockRenderer(t)).ServerResourceTemplates()
+
+	require.Len(t, templates, 1)
+	assert.Equal(t, "projectkit://standards/{id}", templates[0].Template.URITemplate.Raw())
+	assert.Equal(t, "text/markdown", templates[0].Template.MIMEType)
+}
+
+func TestStandardTools_ReadStandard_WhenStandardAddedAfterStart_ThenRendersIt(t *testing.T) {
+	t.Parallel()
+
+	added := testStandards()[1]
+	repository := standardAPI.NewMockRepository(t)
+	repository.EXPECT().GetAll().Return(testStandards()[:1], nil).Once()
+	renderer := standardAPI.NewMockRenderer(t)
+	mcpServer := newStandardServer(t, NewStandardTools(repository, renderer))
+
+	repository.EXPECT().GetAll().Return(testStandards(), nil).Once()
+	renderer.EXPECT().Render(added).Return([]byte("# Commit Messages\n"), nil)
+
+	result := handleMessage[struct {
+		Contents []mcpgo.TextResourceContents `json:"contents"`
+	}](t, mcpServer, "resources/read", map[string]any{"uri": "projectkit://standards/commit-messages"})
+
+	assert.Equal(t, []mcpgo.TextResourceContents{
+		{URI: "projectkit://standards/commit-messages", MIMEType: "text/markdown", Text: "# Commit Messages\n"},
+	}, result.Contents)
+}
+
+func TestStandardTools_ReadStandard_WhenStandardUnknown_ThenReturnsNotFound(t *testing.T) {
+	t.Parallel()
+
+	repository := standardAPI.NewMockRepository(t)
+	repository.EXPECT().GetAll().Return(testStandards(), nil)
+
+	_, err := readStandard(t, NewStandardTools(repository, standardAPI.NewMockRenderer(t)), "projectkit://standards/unknown")
+
+	require.ErrorIs(t, err, standardAPI.ErrStandardNotFound)
+}
+
+func TestStandardTools_ReadStandard_WhenRepositoryFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	repository := standardAPI.NewMockRepository(t)
+	repository.EXPECT().GetAll().Return(nil, errors.New("disk failure"))
+
+	_, err := readStandard(t, NewStandardTools(repository, nil), "projectkit://standards/commit-messages")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "get all standards: disk failure")
+}
+
+func TestStandardTools_Search_WhenFiltered_ThenReturnsMatchingRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		arguments map[string]any
+		want      []standardAPI.StandardId
+	}{
+		{name: "no filters", arguments: nil, want: []standardAPI.StandardId{"go-structured-logging", "commit-messages"}},
+		{name: "tag", arguments: map[string]any{"tag": "logging"}, want: []standardAPI.StandardId{"go-structured-logging"}},
+		{name: "language", arguments: map[string]any{"language": "PL"}, want: []standardAPI.StandardId{"commit-messages"}},
+		{name: "applies to", arguments: map[string]any{"appliesTo": "cli"}, want: []standardAPI.StandardId{"go-structured-logging"}},
+		{name: "combined", arguments: map[string]any{"tag": "git", "appliesTo": "cli"}, want: []standardAPI.StandardId{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repository := standardAPI.NewMockRepository(t)
+			repository.EXPECT().GetAll().Return(testStandards(), nil)
+
+			view := searchStandards(t, NewStandardTools(repository, nil), tt.arguments)
+
+			ids := []standardAPI.StandardId{}
+			for _, standard := range view.Standards {
+				ids = append(ids, standard.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+func TestStandardTools_Search_WhenStandardMatches_ThenReturnsRulesAndResourceURI(t *testing.T) {
+	t.Parallel()
+
+	repository := standardAPI.NewMockRepository(t)
+	repository.EXPECT().GetAll().Return(testStandards(), nil)
+
+	view := searchStandards(t, NewStandardTools(repository, nil), map[string]any{"tag": "logging"})
+
+	require.Len(t, view.Standards, 1)
+	assert.Equal(t, "projectkit://standards/go-structured-logging", view.Standards[0].URI)
+	assert.Equal(t, testStandards()[0].Requirements.Rules, view.Standards[0].Rules)
+}
@@ -0,0 +1,421 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/invopop/jsonschema"
+	mcpgo "github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+// WorkflowTools exposes the workflows and executions of a workflow repository as MCP tools, so that an agent
+// can follow a workflow step by step. The skills and tools a step references are resolved from their repositories
+// and returned together with the step.
+type WorkflowTools struct {
+	workflowRepository workflowAPI.Repository
+	workflowService    workflowAPI.Service
+	skillRepository    skillAPI.Repository
+	toolRepository     toolAPI.Repository
+}
+
+func NewWorkflowTools(
+	workflowRepository workflowAPI.Repository,
+	workflowService workflowAPI.Service,
+	skillRepository skillAPI.Repository,
+	toolRepository toolAPI.Repository,
+) *WorkflowTools {
+	return &WorkflowTools{
+		workflowRepository: workflowRepository,
+		workflowService:    workflowService,
+		skillRepository:    skillRepository,
+		toolRepository:     toolRepository,
+	}
+}
+
+// ServerTools returns the workflow tools together with their handlers.
+func (tools *WorkflowTools) ServerTools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Tool: mcpgo.NewTool("workflow_list",
+				mcpgo.WithDescription("List the workflows available in the project."),
+				mcpgo.WithReadOnlyHintAnnotation(true),
+			),
+			Handler: tools.handleList,
+		},
+		{
+			Tool: mcpgo.NewTool("workflow_start",
+				mcpgo.WithDescription("Start a new execution of a workflow and return its first step."),
+				mcpgo.WithString("workflowId", mcpgo.Required(), mcpgo.Description("ID of the workflow to start.")),
+			),
+			Handler: tools.handleStart,
+		},
+		{
+			Tool: mcpgo.NewTool("workflow_current_step",
+				mcpgo.WithDescription("Return the current step and state values of a workflow execution. An execution awaiting approval must not be worked on until a human approves it. A suspended execution waits for the sub-workflow execution in childExecutionId; work on that one instead."),
+				mcpgo.WithString("executionId", mcpgo.Required(), mcpgo.Description("ID of the workflow execution.")),
+				mcpgo.WithReadOnlyHintAnnotation(true),
+			),
+			Handler: tools.handleCurrentStep,
+		},
+		{
+			Tool: mcpgo.NewTool("workflow_set_state",
+				mcpgo.WithDescription("Store a value in a state variable declared by the workflow. The value must match the schema of the variable."),
+				mcpgo.WithString("executionId", mcpgo.Required(), mcpgo.Description("ID of the workflow execution.")),
+				mcpgo.WithString("key", mcpgo.Required(), mcpgo.Description("Name of the state variable.")),
+				mcpgo.WithAny("value", mcpgo.Required(), mcpgo.Description("Value of the state variable.")),
+			),
+			Handler: tools.handleSetState,
+		},
+		{
+			Tool: mcpgo.NewTool("workflow_next_step",
+				mcpgo.WithDescription("Complete the current step of a workflow execution and return the next one. All outputs of the current step must be set first. Completing the last step completes the execution. Completing a sub-workflow execution resumes the execution in parentExecutionId, continue with that one."),
+				mcpgo.WithString("executionId", mcpgo.Required(), mcpgo.Description("ID of the workflow execution.")),
+			),
+			Handler: tools.handleNextStep,
+		},
+		{
+			Tool: mcpgo.NewTool("workflow_history",
+				mcpgo.WithDescription("Return the history of a workflow execution: when it was started, which steps were entered and completed, how the state changed and who approved, rejected or aborted it."),
+				mcpgo.WithString("executionId", mcpgo.Required(), mcpgo.Description("ID of the workflow execution.")),
+				mcpgo.WithReadOnlyHintAnnotation(true),
+			),
+			Handler: tools.handleHistory,
+		},
+	}
+}
+
+type workflowView struct {
+	ID          workflowAPI.WorkflowId `json:"id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Version     string                 `json:"version"`
+	StepsCount  int                    `json:"stepsCount"`
+	Source      string                 `json:"source,omitempty"`
+}
+
+type stepView struct {
+	ID           string                   `json:"id"`
+	Name         string                   `json:"name"`
+	Description  string                   `json:"description"`
+	Instructions []string                 `json:"instructions"`
+	Outputs      []string                 `json:"outputs,omitempty"`
+	Transitions  []workflowAPI.Transition `json:"transitions,omitempty"`
+	Terminal     bool                     `json:"terminal,omitempty"`
+	Approval     *workflowAPI.Approval    `json:"approval,omitempty"`
+	Workflow     *workflowAPI.Invocation  `json:"workflow,omitempty"`
+	Skills       []skillView              `json:"skills,omitempty"`
+	Tools        []toolView               `json:"tools,omitempty"`
+}
+
+type skillView struct {
+	Name         skillAPI.Name                  `json:"name"`
+	Description  string                         `json:"description"`
+	Instructions string                         `json:"instructions"`
+	Scripts      map[skillAPI.ScriptName]string `json:"scripts,omitempty"`
+}
+
+type toolView struct {
+	ID          toolAPI.ToolId    `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Commands    []toolAPI.Command `json:"commands"`
+}
+
+type executionView struct {
+	ExecutionID workflowAPI.ExecutionId       `json:"executionId"`
+	WorkflowID  workflowAPI.WorkflowId        `json:"workflowId"`
+	Version     string                        `json:"workflowVersion"`
+	Status      workflowAPI.ExecutionStatus   `json:"status"`
+	StepNumber  int                           `json:"stepNumber"`
+	StepsCount  int                           `json:"stepsCount"`
+	Step        stepView                      `json:"step"`
+	StateValues map[string]any                `json:"stateValues"`
+	StateSchema map[string]*jsonschema.Schema `json:"stateSchema,omitempty"`
+	Rejection   *workflowAPI.Rejection        `json:"rejection,omitempty"`
+
+	ParentExecutionID workflowAPI.ExecutionId `json:"parentExecutionId,omitempty"`
+	ChildExecutionID  workflowAPI.ExecutionId `json:"childExecutionId,omitempty"`
+}
+
+type workflowListView struct {
+	Workflows []workflowView `json:"workflows"`
+}
+
+type historyView struct {
+	ExecutionID workflowAPI.ExecutionId `json:"executionId"`
+	Events      []workflowAPI.Event     `json:"events"`
+}
+
+func (tools *WorkflowTools) handleList(_ context.Context, _ mcpgo.CallToolRequest) (*mcpgo.CallToolResult, error) {
+	workflows, err := tools.workflowRepository.GetAllWorkflows()
+	if err != nil {
+		return mcpgo.NewToolResultErrorFromErr("get all workflows", err), nil
+	}
+
+	view := workflowListView{Workflows: make([]workflowView, 0, len(workflows))}
+	for _, workflow := range workflows {
+		item := workflowView{
+			ID:          workflow.Metadata.ID,
+			Name:        workflow.Metadata.Name,
+			Description: workflow.Metadata.Description,
+			Version:     workflow.Metadata.Version,
+			StepsCount:  len(workflow.Steps),
+		}
+		if workflow.Provenance != nil {
+			item.Source = workflow.Provenance.String()
+		}
+
+		view.Workflows = append(view.Workflows, item)
+	}
+
+	return mcpgo.NewToolResultStructuredOnly(view), nil
+}
+
+func (tools *WorkflowTools) handleStart(ctx context.Context, request mcpgo.CallToolRequest) (*mcpgo.CallToolResult, error) {
+	workflowId, err := request.RequireString("workflowId")
+	if err != nil {
+		return mcpgo.NewToolResultError(err.Error()), nil
+	}
+
+	executionId, err := tools.workflowService.Execute(agentActor(ctx), workflowAPI.WorkflowId(workflowId))
+	if err != nil {
+		return mcpgo.NewToolResultErrorFromErr("start workflow", err), nil
+	}
+
+	slog.Info("Workflow execution started.",
+		slog.String("workflowId", workflowId),
+		slog.String("executionId", string(executionId)),
+	)
+
+	execution, err := tools.workflowService.GetExecution(executionId)
+	if err != nil {
+		return mcpgo.NewToolResultErrorFromErr("get execution", err), nil
+	}
+
+	return tools.executionResult(*execution), nil
+}
+
+func (tools *WorkflowTools) handleCurrentStep(_ context.Context, request mcpgo.CallToolRequest) (*mcpgo.CallToolResult, error) {
+	executionId, err := request.RequireString("executionId")
+	if err != nil {
+		return mcpgo.NewToolResultError(err.Error()), nil
+	}
+
+	execution, err := tools.workflowService.GetExecution(workflowAPI.ExecutionId(executionId))
+	if err != nil {
+		return mcpgo.NewToolResultErrorFromErr("get execution", err), nil
+	}
+
+	return tools.executionResult(*execution), nil
+}
+
+func (tools *WorkflowTools) handleSetState(ctx context.Context, request mcpgo.CallToolRequest) (*mcpgo.CallToolResult, error) {
+	executionId, err := request.RequireString("executionId")
+	if err != nil {
+		return mcpgo.NewToolResultError(err.Error()), nil
+	}
+
+	key, err := request.RequireString("key")
+	if err != nil {
+		return mcpgo.NewToolResultError(err.Error()), nil
+	}
+
+	value, found := request.GetArguments()["value"]
+	if !found {
+		return mcpgo.NewToolResultError(`required argument "value" not found`), nil
+	}
+
+	execution, err := tools.workflowService.SetState(agentActor(ctx), workflowAPI.ExecutionId(executionId), key, value)
+	if err != nil {
+		return toolError("set state", err), nil
+	}
+
+	return tools.executionResult(*execution), nil
+}
+
+func (tools *WorkflowTools) handleNextStep(ctx context.Context, request mcpgo.CallToolRequest) (*mcpgo.CallToolResult, error) {
+	executionId, err := request.RequireString("executionId")
+	if err != nil {
+		return mcpgo.NewToolResultError(err.Error()), nil
+	}
+
+	execution, err := tools.workflowService.Next(agentActor(ctx), workflowAPI.ExecutionId(executionId))
+	if err != nil {
+		return toolError("next step", err), nil
+	}
+
+	slog.Info("Workflow execution advanced.",
+		slog.String("workflowId", string(execution.WorkflowId)),
+		slog.String("executionId", string(execution.Id)),
+		slog.String("stepId", execution.StepId),
+		slog.String("status", string(execution.Status)),
+	)
+
+	return tools.executionResult(*execution), nil
+}
+
+func (tools *WorkflowTools) handleHistory(_ context.Context, request mcpgo.CallToolRequest) (*mcpgo.CallToolResult, error) {
+	executionId, err := request.RequireString("executionId")
+	if err != nil {
+		return mcpgo.NewToolResultError(err.Error()), nil
+	}
+
+	events, err := tools.workflowService.History(workflowAPI.ExecutionId(executionId))
+	if err != nil {
+		return mcpgo.NewToolResultErrorFromErr("get history", err), nil
+	}
+
+	return mcpgo.NewToolResultStructuredOnly(historyView{
+		ExecutionID: workflowAPI.ExecutionId(executionId),
+		Events:      events,
+	}), nil
+}
+
+func (tools *WorkflowTools) executionResult(execution workflowAPI.Execution) *mcpgo.CallToolResult {
+	workflow, err := tools.workflowRepository.GetWorkflowVersion(execution.WorkflowId, execution.WorkflowVersion)
+	if err != nil {
+		return mcpgo.NewToolResultErrorFromErr(fmt.Sprintf("get workflow %s", execution.WorkflowId), err)
+	}
+
+	index, found := workflow.StepIndex(execution.StepId)
+	if !found {
+		return mcpgo.NewToolResultErrorFromErr(fmt.Sprintf("execution %s step %s", execution.Id, execution.StepId), workflowAPI.ErrStepNotFound)
+	}
+
+	step := workflow.Steps[index]
+
+	skills, err := tools.stepSkills(step)
+	if err != nil {
+		return mcpgo.NewToolResultErrorFromErr(fmt.Sprintf("step %s skills", step.ID), err)
+	}
+
+	stepTools, err := tools.stepTools(step)
+	if err != nil {
+		return mcpgo.NewToolResultErrorFromErr(fmt.Sprintf("step %s tools", step.ID), err)
+	}
+
+	stateValues := execution.StateValues
+	if stateValues == nil {
+		stateValues = map[string]any{}
+	}
+
+	return mcpgo.NewToolResultStructuredOnly(executionView{
+		ExecutionID: execution.Id,
+		WorkflowID:  execution.WorkflowId,
+		Version:     workflow.Metadata.Version,
+		Status:      execution.Status,
+		StepNumber:  index + 1,
+		StepsCount:  len(workflow.Steps),
+		Step: stepView{
+			ID:           step.ID,
+			Name:         step.Name,
+			Description:  step.Description,
+			Instructions: step.Instructions,
+			Outputs:      step.Outputs,
+			Transitions:  step.Transitions,
+			Terminal:     step.Terminal,
+			Approval:     step.Approval,
+			Workflow:     step.Workflow,
+			Skills:       skills,
+			Tools:        stepTools,
+		},
+		StateValues: stateValues,
+		StateSchema: workflow.State,
+		Rejection:   execution.Rejection,
+
+		ParentExecutionID: execution.ParentExecutionId,
+		ChildExecutionID:  execution.ChildExecutionId,
+	})
+}
+
+func (tools *WorkflowTools) stepSkills(step workflowAPI.Step) ([]skillView, error) {
+	var views []skillView
+	for _, name := range step.Skills {
+		skill, err := tools.skillRepository.GetSkillByName(name)
+		if err != nil {
+			return nil, fmt.Errorf("get skill %s: %w", name, err)
+		}
+
+		view := skillView{
+			Name:         skill.Metadata.Name,
+			Description:  skill.Metadata.Description,
+			Instructions: skill.Instructions,
+		}
+		if len(skill.Scripts) > 0 {
+			view.Scripts = make(map[skillAPI.ScriptName]string, len(skill.Scripts))
+			for scriptName, script := range skill.Scripts {
+				view.Scripts[scriptName] = string(script.Content)
+			}
+		}
+
+		views = append(views, view)
+	}
+
+	return views, nil
+}
+
+func (tools *WorkflowTools) stepTools(step workflowAPI.Step) ([]toolView, error) {
+	if len(step.Tools) == 0 {
+		return nil, nil
+	}
+
+	allTools, err := tools.toolRepository.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("get all tools: %w", err)
+	}
+
+	toolsById := make(map[toolAPI.ToolId]toolAPI.Tool, len(allTools))
+	for _, tool := range allTools {
+		toolsById[tool.Metadata.ID] = tool
+	}
+
+	views := make([]toolView, 0, len(step.Tools))
+	for _, toolId := range step.Tools {
+		tool, found := toolsById[toolId]
+		if !found {
+			return nil, fmt.Errorf("tool %s: %w", toolId, toolAPI.ErrToolNotFound)
+		}
+
+		views = append(views, toolView{
+			ID:          tool.Metadata.ID,
+			Name:        tool.Metadata.Name,
+			Description: tool.Metadata.Description,
+			Commands:    tool.Definition.Commands,
+		})
+	}
+
+	return views, nil
+}
+
+// toolError reports a failed tool call. State and step output errors are attached as structured content, so
+// the agent can tell which values to fix.
+func toolError(text string, err error) *mcpgo.CallToolResult {
+	result := mcpgo.NewToolResultErrorFromErr(text, err)
+
+	var stateErr *workflowAPI.StateError
+	var outputsErr *workflowAPI.OutputsError
+	switch {
+	case errors.As(err, &stateErr):
+		result.StructuredContent = stateErr
+	case errors.As(err, &outputsErr):
+		result.StructuredContent = outputsErr
+	}
+
+	return result
+}
+
+// agentActor identifies the agent calling a tool by the client name it reported when initializing the session.
+func agentActor(ctx context.Context) workflowAPI.Actor {
+	actor := workflowAPI.Actor{Kind: workflowAPI.ActorKindAgent}
+	if session, ok := server.ClientSessionFromContext(ctx).(server.SessionWithClientInfo); ok {
+		actor.Name = session.GetClientInfo().Name
+	}
+
+	return actor
+}
@@ -0,0 +1,460 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/coder/quartz"
+	"github.com/invopop/jsonschema"
+	mcpgo "github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	workflowInternal "github.com/orbiqd/orbiqd-projectkit/internal/pkg/ai/workflow"
+	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testWorkflow() workflowAPI.Workflow {
+	return workflowAPI.Workflow{
+		Metadata: workflowAPI.Metadata{
+			ID:          "example",
+			Name:        "Example",
+			Description: "Example workflow.",
+			Version:     "0.1.0",
+		},
+		State: map[string]*jsonschema.Schema{
+			"firstNumber": {Type: "integer"},
+		},
+		Steps: []workflowAPI.Step{
+			{ID: "prepare", Name: "Prepare", Description: "Prepare numbers.", Instructions: []string{"Pick a number."}},
+			{ID: "add", Name: "Add", Description: "Add numbers.", Instructions: []string{"Add the numbers."}},
+		},
+		Provenance: &sourceAPI.Provenance{SourceURI: "local://./rulebooks/general", Path: "ai/workflows/example.yaml"},
+	}
+}
+
+func newTestTools(t *testing.T) (*WorkflowTools, workflowAPI.Repository) {
+	t.Helper()
+
+	repository := workflowInternal.NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewReal())
+	require.NoError(t, repository.AddWorkflow(testWorkflow()))
+
+	return NewWorkflowTools(repository, workflowInternal.NewEngine(repository, quartz.NewReal()), nil, nil), repository
+}
+
+func callTool(t *testing.T, tools *WorkflowTools, name string, arguments map[string]any) *mcpgo.CallToolResult {
+	t.Helper()
+
+	for _, serverTool := range tools.ServerTools() {
+		if serverTool.Tool.Name != name {
+			continue
+		}
+
+		request := mcpgo.CallToolRequest{}
+		request.Params.Name = name
+		request.Params.Arguments = arguments
+
+		result, err := serverTool.Handler(context.Background(), request)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+
+		return result
+	}
+
+	t.Fatalf("tool %s not registered", name)
+	return nil
+}
+
+func resultText(t *testing.T, result *mcpgo.CallToolResult) string {
+	t.Helper()
+
+	require.Len(t, result.Content, 1)
+	content, ok := mcpgo.AsTextContent(result.Content[0])
+	require.True(t, ok)
+
+	return content.Text
+}
+
+func decodeExecution(t *testing.T, result *mcpgo.CallToolResult) executionView {
+	t.Helper()
+
+	require.False(t, result.IsError, resultText(t, result))
+
+	var view executionView
+	require.NoError(t, json.Unmarshal([]byte(resultText(t, result)), &view))
+
+	return view
+}
+
+func TestWorkflowTools_ServerTools_ThenRegistersWorkflowTools(t *testing.T) {
+	t.Parallel()
+
+	var names []string
+	for _, serverTool := range NewWorkflowTools(nil, nil, nil, nil).ServerTools() {
+		names = append(names, serverTool.Tool.Name)
+	}
+
+	assert.Equal(t, []string{"workflow_list", "workflow_start", "workflow_current_step", "workflow_set_state", "workflow_next_step", "workflow_history"}, names)
+}
+
+func TestWorkflowTools_List_WhenWorkflowsStored_ThenReturnsWorkflows(t *testing.T) {
+	t.Parallel()
+
+	tools, _ := newTestTools(t)
+
+	result := callTool(t, tools, "workflow_list", nil)
+
+	require.False(t, result.IsError)
+	var view workflowListView
+	require.NoError(t, json.Unmarshal([]byte(resultText(t, result)), &view))
+	require.Len(t, view.Workflows, 1)
+	assert.Equal(t, workflowAPI.WorkflowId("example"), view.Workflows[0].ID)
+	assert.Equal(t, 2, view.Workflows[0].StepsCount)
+	assert.Equal(t, "ai/workflows/example.yaml from local://./rulebooks/general", view.Workflows[0].Source)
+}
+
+func TestWorkflowTools_List_WhenRepositoryFails_ThenReturnsToolError(t *testing.T) {
+	t.Parallel()
+
+	repository := workflowAPI.NewMockRepository(t)
+	repository.EXPECT().GetAllWorkflows().Return(nil, errors.New("disk failure"))
+
+	result := callTool(t, NewWorkflowTools(repository, nil, nil, nil), "workflow_list", nil)
+
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(t, result), "get all workflows: disk failure")
+}
+
+func TestWorkflowTools_Start_WhenWorkflowExists_ThenStartsAtFirstStep(t *testing.T) {
+	t.Parallel()
+
+	tools, repository := newTestTools(t)
+
+	view := decodeExecution(t, callTool(t, tools, "workflow_start", map[string]any{"workflowId": "example"}))
+
+	assert.NotEmpty(t, view.ExecutionID)
+	assert.Equal(t, "0.1.0", view.Version)
+	assert.Equal(t, "prepare", view.Step.ID)
+	assert.Equal(t, 1, view.StepNumber)
+	assert.Equal(t, 2, view.StepsCount)
+	assert.Equal(t, workflowAPI.ExecutionStatusPending, view.Status)
+
+	execution, err := repository.GetExecutionById(view.ExecutionID)
+	require.NoError(t, err)
+	assert.Equal(t, "prepare", execution.StepId)
+}
+
+func TestWorkflowTools_Start_WhenWorkflowNotFound_ThenReturnsToolError(t *testing.T) {
+	t.Parallel()
+
+	tools, _ := newTestTools(t)
+
+	result := callTool(t, tools, "workflow_start", map[string]any{"workflowId": "missing"})
+
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(t, result), workflowAPI.ErrWorkflowNotFound.Error())
+}
+
+func TestWorkflowTools_Start_WhenWorkflowIdMissing_ThenReturnsToolError(t *testing.T) {
+	t.Parallel()
+
+	tools, _ := newTestTools(t)
+
+	result := callTool(t, tools, "workflow_start", map[string]any{})
+
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(t, result), "workflowId")
+}
+
+func TestWorkflowTools_CurrentStep_WhenExecutionNotFound_ThenReturnsToolError(t *testing.T) {
+	t.Parallel()
+
+	tools, _ := newTestTools(t)
+
+	result := callTool(t, tools, "workflow_current_step", map[string]any{"executionId": "missing"})
+
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(t, result), workflowAPI.ErrExecutionNotFound.Error())
+}
+
+func TestWorkflowTools_SetState_WhenKeyDeclared_ThenStoresValue(t *testing.T) {
+	t.Parallel()
+
+	tools, repository := newTestTools(t)
+	started := decodeExecution(t, callTool(t, tools, "workflow_start", map[string]any{"workflowId": "example"}))
+
+	view := decodeExecution(t, callTool(t, tools, "workflow_set_state", map[string]any{
+		"executionId": string(started.ExecutionID),
+		"key":         "firstNumber",
+		"value":       42,
+	}))
+
+	assert.EqualValues(t, 42, view.StateValues["firstNumber"])
+	assert.Equal(t, workflowAPI.ExecutionStatusRunning, view.Status)
+
+	execution, err := repository.GetExecutionById(started.ExecutionID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 42, execution.StateValues["firstNumber"])
+}
+
+func TestWorkflowTools_SetState_WhenKeyNotDeclared_ThenReturnsToolError(t *testing.T) {
+	t.Parallel()
+
+	tools, _ := newTestTools(t)
+	started := decodeExecution(t, callTool(t, tools, "workflow_start", map[string]any{"workflowId": "example"}))
+
+	result := callTool(t, tools, "workflow_set_state", map[string]any{
+		"executionId": string(started.ExecutionID),
+		"key":         "unknown",
+		"value":       1,
+	})
+
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(t, result), workflowAPI.ErrStateNotDeclared.Error())
+}
+
+func TestWorkflowTools_SetState_WhenValueBreaksSchema_ThenReturnsStructuredError(t *testing.T) {
+	t.Parallel()
+
+	tools, _ := newTestTools(t)
+	started := decodeExecution(t, callTool(t, tools, "workflow_start", map[string]any{"workflowId": "example"}))
+
+	result := callTool(t, tools, "workflow_set_state", map[string]any{
+		"executionId": string(started.ExecutionID),
+		"key":         "firstNumber",
+		"value":       "forty two",
+	})
+
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(t, result), workflowAPI.ErrStateInvalid.Error())
+	stateErr, ok := result.StructuredContent.(*workflowAPI.StateError)
+	require.True(t, ok)
+	assert.Equal(t, "firstNumber", stateErr.Key)
+	assert.NotEmpty(t, stateErr.Violations)
+}
+
+func TestWorkflowTools_SetState_WhenValueMissing_ThenReturnsToolError(t *testing.T) {
+	t.Parallel()
+
+	tools, _ := newTestTools(t)
+
+	result := callTool(t, tools, "workflow_set_state", map[string]any{"executionId": "any", "key": "firstNumber"})
+
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(t, result), "value")
+}
+
+func TestWorkflowTools_NextStep_WhenStepsRemain_ThenAdvancesExecution(t *testing.T) {
+	t.Parallel()
+
+	tools, _ := newTestTools(t)
+	started := decodeExecution(t, callTool(t, tools, "workflow_start", map[string]any{"workflowId": "example"}))
+
+	view := decodeExecution(t, callTool(t, tools, "workflow_next_step", map[string]any{"executionId": string(started.ExecutionID)}))
+
+	assert.Equal(t, "add", view.Step.ID)
+	assert.Equal(t, 2, view.StepNumber)
+	assert.Equal(t, workflowAPI.ExecutionStatusRunning, view.Status)
+
+	current := decodeExecution(t, callTool(t, tools, "workflow_current_step", map[string]any{"executionId": string(started.ExecutionID)}))
+	assert.Equal(t, "add", current.Step.ID)
+}
+
+func TestWorkflowTools_NextStep_WhenOutputsMissing_ThenReturnsStructuredError(t *testing.T) {
+	t.Parallel()
+
+	tools, repository := newTestTools(t)
+	workflow := testWorkflow()
+	workflow.Metadata.ID = "with-outputs"
+	workflow.Steps[0].Outputs = []string{"firstNumber"}
+	require.NoError(t, repository.AddWorkflow(workflow))
+	started := decodeExecution(t, callTool(t, tools, "workflow_start", map[string]any{"workflowId": "with-outputs"}))
+	assert.Equal(t, []string{"firstNumber"}, started.Step.Outputs)
+
+	result := callTool(t, tools, "workflow_next_step", map[string]any{"executionId": string(started.ExecutionID)})
+
+	assert.True(t, result.IsError)
+	outputsErr, ok := result.StructuredContent.(*workflowAPI.OutputsError)
+	require.True(t, ok)
+	assert.Equal(t, []string{"firstNumber"}, outputsErr.Missing)
+}
+
+func TestWorkflowTools_NextStep_WhenOnLastStep_ThenCompletesExecution(t *testing.T) {
+	t.Parallel()
+
+	tools, _ := newTestTools(t)
+	started := decodeExecution(t, callTool(t, tools, "workflow_start", map[string]any{"workflowId": "example"}))
+	decodeExecution(t, callTool(t, tools, "workflow_next_step", map[string]any{"executionId": string(started.ExecutionID)}))
+
+	view := decodeExecution(t, callTool(t, tools, "workflow_next_step", map[string]any{"executionId": string(started.ExecutionID)}))
+
+	assert.Equal(t, "add", view.Step.ID)
+	assert.Equal(t, workflowAPI.ExecutionStatusCompleted, view.Status)
+}
+
+func TestWorkflowTools_NextStep_WhenExecutionCompleted_ThenReturnsToolError(t *testing.T) {
+	t.Parallel()
+
+	tools, _ := newTestTools(t)
+	started := decodeExecution(t, callTool(t, tools, "workflow_start", map[string]any{"workflowId": "example"}))
+	for range 2 {
+		decodeExecution(t, callTool(t, tools, "workflow_next_step", map[string]any{"executionId": string(started.ExecutionID)}))
+	}
+
+	result := callTool(t, tools, "workflow_next_step", map[string]any{"executionId": string(started.ExecutionID)})
+
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(t, result), workflowAPI.ErrExecutionFinished.Error())
+}
+
+func TestWorkflowTools_CurrentStep_WhenApprovalRejected_ThenReturnsReason(t *testing.T) {
+	t.Parallel()
+
+	tools, repository := newTestTools(t)
+	workflow := testWorkflow()
+	workflow.Metadata.ID = "gated"
+	workflow.Steps[1].Approval = &workflowAPI.Approval{Message: "Check the numbers."}
+	require.NoError(t, repository.AddWorkflow(workflow))
+	engine := workflowInternal.NewEngine(repository, quartz.NewReal())
+	started := decodeExecution(t, callTool(t, tools, "workflow_start", map[string]any{"workflowId": "gated"}))
+
+	awaiting := decodeExecution(t, callTool(t, tools, "workflow_next_step", map[string]any{"executionId": string(started.ExecutionID)}))
+	assert.Equal(t, workflowAPI.ExecutionStatusAwaitingApproval, awaiting.Status)
+	require.NotNil(t, awaiting.Step.Approval)
+	assert.Equal(t, "Check the numbers.", awaiting.Step.Approval.Message)
+
+	_, err := engine.Reject(workflowAPI.Actor{Kind: workflowAPI.ActorKindHuman}, started.ExecutionID, "Pick larger numbers.")
+	require.NoError(t, err)
+
+	view := decodeExecution(t, callTool(t, tools, "workflow_current_step", map[string]any{"executionId": string(started.ExecutionID)}))
+
+	assert.Equal(t, "prepare", view.Step.ID)
+	require.NotNil(t, view.Rejection)
+	assert.Equal(t, "Pick larger numbers.", view.Rejection.Reason)
+}
+
+func TestWorkflowTools_NextStep_WhenSubWorkflowInvoked_ThenLinksParentAndChild(t *testing.T) {
+	t.Parallel()
+
+	tools, repository := newTestTools(t)
+	workflow := testWorkflow()
+	workflow.Metadata.ID = "composed"
+	workflow.Steps[1] = workflowAPI.Step{
+		ID:          "example",
+		Name:        "Example",
+		Description: "Run the example workflow.",
+		Workflow:    &workflowAPI.Invocation{WorkflowId: "example", Inputs: map[string]string{"firstNumber": "firstNumber"}},
+	}
+	require.NoError(t, repository.AddWorkflow(workflow))
+	started := decodeExecution(t, callTool(t, tools, "workflow_start", map[string]any{"workflowId": "composed"}))
+
+	suspended := decodeExecution(t, callTool(t, tools, "workflow_next_step", map[string]any{"executionId": string(started.ExecutionID)}))
+
+	assert.Equal(t, workflowAPI.ExecutionStatusSuspended, suspended.Status)
+	require.NotNil(t, suspended.Step.Workflow)
+	assert.Equal(t, workflowAPI.WorkflowId("example"), suspended.Step.Workflow.WorkflowId)
+	require.NotEmpty(t, suspended.ChildExecutionID)
+
+	child := decodeExecution(t, callTool(t, tools, "workflow_current_step", map[string]any{"executionId": string(suspended.ChildExecutionID)}))
+	assert.Equal(t, "prepare", child.Step.ID)
+	assert.Equal(t, started.ExecutionID, child.ParentExecutionID)
+}
+
+func newReferencingTools(t *testing.T, skillRepository skillAPI.Repository, toolRepository toolAPI.Repository) *WorkflowTools {
+	t.Helper()
+
+	workflow := testWorkflow()
+	workflow.Steps[0].Skills = []skillAPI.Name{"go-testing"}
+	workflow.Steps[0].Tools = []toolAPI.ToolId{"go-test"}
+
+	repository := workflowInternal.NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewReal())
+	require.NoError(t, repository.AddWorkflow(workflow))
+
+	return NewWorkflowTools(repository, workflowInternal.NewEngine(repository, quartz.NewReal()), skillRepository, toolRepository)
+}
+
+func TestWorkflowTools_Start_WhenStepReferencesSkillsAndTools_ThenReturnsThem(t *testing.T) {
+	t.Parallel()
+
+	skillRepository := skillAPI.NewMockRepository(t)
+	skillRepository.EXPECT().GetSkillByName(skillAPI.Name("go-testing")).Return(&skillAPI.Skill{
+		Metadata:     skillAPI.Metadata{Name: "go-testing", Description: "Writing Go tests."},
+		Instructions: "Use table-driven tests.",
+		Scripts:      map[skillAPI.ScriptName]skillAPI.Script{"run.sh": {ContentType: "text/x-shellscript", Content: []byte("go test ./...")}},
+	}, nil)
+	toolRepository := toolAPI.NewMockRepository(t)
+	toolRepository.EXPECT().GetAll().Return([]toolAPI.Tool{
+		{
+			Metadata:   toolAPI.Metadata{ID: "go-test", Name: "Go Test", Description: "Runs Go tests."},
+			Definition: toolAPI.Definition{Commands: []toolAPI.Command{"go test"}},
+		},
+	}, nil)
+
+	view := decodeExecution(t, callTool(t, newReferencingTools(t, skillRepository, toolRepository), "workflow_start", map[string]any{"workflowId": "example"}))
+
+	require.Len(t, view.Step.Skills, 1)
+	assert.Equal(t, skillAPI.Name("go-testing"), view.Step.Skills[0].Name)
+	assert.Equal(t, "Use table-driven tests.", view.Step.Skills[0].Instructions)
+	assert.Equal(t, map[skillAPI.ScriptName]string{"run.sh": "go test ./..."}, view.Step.Skills[0].Scripts)
+	require.Len(t, view.Step.Tools, 1)
+	assert.Equal(t, toolAPI.ToolId("go-test"), view.Step.Tools[0].ID)
+	assert.Equal(t, []toolAPI.Command{"go test"}, view.Step.Tools[0].Commands)
+}
+
+func TestWorkflowTools_Start_WhenReferencedToolMissing_ThenReturnsToolError(t *testing.T) {
+	t.Parallel()
+
+	skillRepository := skillAPI.NewMockRepository(t)
+	skillRepository.EXPECT().GetSkillByName(skillAPI.Name("go-testing")).Return(&skillAPI.Skill{}, nil)
+	toolRepository := toolAPI.NewMockRepository(t)
+	toolRepository.EXPECT().GetAll().Return(nil, nil)
+
+	result := callTool(t, newReferencingTools(t, skillRepository, toolRepository), "workflow_start", map[string]any{"workflowId": "example"})
+
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(t, result), "tool go-test: tool not found")
+}
+
+func TestWorkflowTools_History_WhenAgentAdvances_ThenReturnsEventsOfAgent(t *testing.T) {
+	t.Parallel()
+
+	tools, _ := newTestTools(t)
+	session := server.NewInProcessSession("session-1", nil)
+	session.SetClientInfo(mcpgo.Implementation{Name: "claude-code", Version: "2.0.0"})
+	ctx := server.NewMCPServer("projectkit", "1.0.0").WithContext(context.Background(), session)
+
+	request := mcpgo.CallToolRequest{}
+	request.Params.Arguments = map[string]any{"workflowId": "example"}
+	result, err := tools.handleStart(ctx, request)
+	require.NoError(t, err)
+	started := decodeExecution(t, result)
+
+	result = callTool(t, tools, "workflow_history", map[string]any{"executionId": string(started.ExecutionID)})
+
+	require.False(t, result.IsError, resultText(t, result))
+	var view historyView
+	require.NoError(t, json.Unmarshal([]byte(resultText(t, result)), &view))
+	assert.Equal(t, started.ExecutionID, view.ExecutionID)
+	require.Len(t, view.Events, 2)
+	assert.Equal(t, workflowAPI.EventTypeStarted, view.Events[0].Type)
+	assert.Equal(t, workflowAPI.Actor{Kind: workflowAPI.ActorKindAgent, Name: "claude-code"}, view.Events[0].Actor)
+	assert.Equal(t, workflowAPI.EventTypeStepEntered, view.Events[1].Type)
+	assert.Equal(t, "prepare", view.Events[1].StepId)
+}
+
+func TestWorkflowTools_History_WhenExecutionNotFound_ThenReturnsToolError(t *testing.T) {
+	t.Parallel()
+
+	tools, _ := newTestTools(t)
+
+	result := callTool(t, tools, "workflow_history", map[string]any{"executionId": "missing"})
+
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(t, result), workflowAPI.ErrExecutionNotFound.Error())
+}
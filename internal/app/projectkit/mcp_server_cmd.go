@@ -1,17 +1,44 @@
 package projectkit
 
 import (
+	"fmt"
+
 	"github.com/mark3labs/mcp-go/server"
+	"github.com/orbiqd/orbiqd-projectkit/internal/app/projectkit/mcp"
+	"github.com/orbiqd/orbiqd-projectkit/internal/pkg/doc/standard"
+	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+	standardAPI "github.com/orbiqd/orbiqd-projectkit/pkg/doc/standard"
 )
 
 type MCPServerCmd struct {
 }
 
-func (cmd *MCPServerCmd) Run() error {
-	mcp := server.NewMCPServer(
+func (cmd *MCPServerCmd) Run(
+	workflowRepository workflowAPI.Repository,
+	workflowService workflowAPI.Service,
+	skillRepository skillAPI.Repository,
+	toolRepository toolAPI.Repository,
+	standardRepository standardAPI.Repository,
+) error {
+	mcpServer := server.NewMCPServer(
 		"projectkit",
 		"1.0.0",
+		server.WithToolCapabilities(false),
+		server.WithResourceCapabilities(false, false),
 	)
 
-	return server.ServeStdio(mcp)
+	mcpServer.AddTools(mcp.NewWorkflowTools(workflowRepository, workflowService, skillRepository, toolRepository).ServerTools()...)
+
+	standardTools := mcp.NewStandardTools(standardRepository, standard.NewMarkdownRenderer())
+	standardResources, err := standardTools.ServerResources()
+	if err != nil {
+		return fmt.Errorf("standard resources: %w", err)
+	}
+	mcpServer.AddResources(standardResources...)
+	mcpServer.AddResourceTemplates(standardTools.ServerResourceTemplates()...)
+	mcpServer.AddTools(standardTools.ServerTools()...)
+
+	return server.ServeStdio(mcpServer)
 }
@@ -8,6 +8,8 @@ import (
 	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	mcpAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
 	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
 	standardAPI "github.com/orbiqd/orbiqd-projectkit/pkg/doc/standard"
 	projectAPI "github.com/orbiqd/orbiqd-projectkit/pkg/project"
 )
@@ -23,13 +25,23 @@ func (cmd *RenderCmd) Run(
 	projectFs projectAPI.Fs,
 	standardRepository standardAPI.Repository,
 	mcpRepository mcpAPI.Repository,
+	toolRepository toolAPI.Repository,
+	workflowRepository workflowAPI.Repository,
 ) error {
-	if err := action.NewRenderAgentAction(gitFs, *config, agentRegistry, skillRepository, instructionRepository, mcpRepository).Run(); err != nil {
+	if err := action.NewRenderAgentAction(gitFs, *config, agentRegistry, skillRepository, instructionRepository, mcpRepository, toolRepository, workflowRepository).Run(); err != nil {
 		return err
 	}
 
 	renderers := map[string]standardAPI.Renderer{
 		"markdown": standard.NewMarkdownRenderer(),
 	}
-	return action.NewRenderDocStandardAction(standardRepository, projectFs, config.Docs.Standard.Render, renderers).Run()
+	if err := action.NewRenderDocStandardAction(standardRepository, projectFs, config.Docs.Standard.Render, renderers).Run(); err != nil {
+		return err
+	}
+
+	if config.AI == nil || config.AI.Workflows == nil || len(config.AI.Workflows.Render) == 0 {
+		return nil
+	}
+
+	return newRenderDocWorkflowAction(config, projectFs, workflowRepository).Run()
 }
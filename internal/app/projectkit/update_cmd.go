@@ -5,22 +5,27 @@ import (
 	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	mcpAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
 	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
 	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
 	standardAPI "github.com/orbiqd/orbiqd-projectkit/pkg/doc/standard"
 	projectAPI "github.com/orbiqd/orbiqd-projectkit/pkg/project"
 	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
 )
 
-type UpdateCmd struct{}
+type UpdateCmd struct {
+	Locked bool `help:"Fail when a resolved source no longer matches the lockfile instead of rewriting it."`
+}
 
 func (cmd *UpdateCmd) Run(
 	config *projectAPI.Config,
+	projectFs projectAPI.Fs,
 	sourceResolver sourceAPI.Resolver,
 	instructionRepository instructionAPI.Repository,
 	skillRepository skillAPI.Repository,
 	workflowRepository workflowAPI.Repository,
 	mcpRepository mcpAPI.Repository,
+	toolRepository toolAPI.Repository,
 	standardRepository standardAPI.Repository,
 ) error {
-	return action.NewUpdateAction(*config, sourceResolver, instructionRepository, skillRepository, workflowRepository, mcpRepository, standardRepository).Run()
+	return action.NewUpdateAction(*config, projectFs, cmd.Locked, sourceResolver, instructionRepository, skillRepository, workflowRepository, mcpRepository, toolRepository, standardRepository).Run()
 }
@@ -0,0 +1,17 @@
+package projectkit
+
+import (
+	"os"
+
+	"github.com/orbiqd/orbiqd-projectkit/internal/app/projectkit/action"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+type WorkflowAbortCmd struct {
+	ExecutionId string `arg:"" help:"ID of the workflow execution."`
+	JSON        bool   `help:"Print JSON instead of a table." name:"json"`
+}
+
+func (cmd *WorkflowAbortCmd) Run(workflowRepository workflowAPI.Repository, workflowService workflowAPI.Service) error {
+	return action.NewAbortExecutionAction(workflowRepository, workflowService, workflowAPI.ExecutionId(cmd.ExecutionId), os.Stdout, cmd.JSON).Run()
+}
@@ -0,0 +1,14 @@
+package projectkit
+
+import (
+	"github.com/orbiqd/orbiqd-projectkit/internal/app/projectkit/action"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+type WorkflowApproveCmd struct {
+	ExecutionId string `arg:"" help:"ID of the workflow execution."`
+}
+
+func (cmd *WorkflowApproveCmd) Run(workflowService workflowAPI.Service) error {
+	return action.NewApproveExecutionAction(workflowService, workflowAPI.ExecutionId(cmd.ExecutionId)).Run()
+}
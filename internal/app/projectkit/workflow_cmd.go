@@ -0,0 +1,16 @@
+package projectkit
+
+type WorkflowCmd struct {
+	List       WorkflowListCmd       `cmd:"list" help:"List the workflows available in the project."`
+	Show       WorkflowShowCmd       `cmd:"show" help:"Show the state and steps of a workflow."`
+	Start      WorkflowStartCmd      `cmd:"start" help:"Start a new execution of a workflow."`
+	Status     WorkflowStatusCmd     `cmd:"status" help:"Show the current step and state of a workflow execution."`
+	Executions WorkflowExecutionsCmd `cmd:"executions" help:"List workflow executions."`
+	Abort      WorkflowAbortCmd      `cmd:"abort" help:"Abort a workflow execution."`
+	Approve    WorkflowApproveCmd    `cmd:"approve" help:"Approve a workflow execution awaiting approval."`
+	Reject     WorkflowRejectCmd     `cmd:"reject" help:"Reject a workflow execution awaiting approval."`
+	Migrate    WorkflowMigrateCmd    `cmd:"migrate" help:"Move a workflow execution to the current version of its workflow."`
+	History    WorkflowHistoryCmd    `cmd:"history" help:"Show the history of a workflow execution."`
+	GC         WorkflowGCCmd         `cmd:"gc" help:"Remove finished workflow executions beyond the configured retention."`
+	Render     WorkflowRenderCmd     `cmd:"render" help:"Render workflows to documentation files."`
+}
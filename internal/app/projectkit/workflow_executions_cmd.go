@@ -0,0 +1,17 @@
+package projectkit
+
+import (
+	"os"
+
+	"github.com/orbiqd/orbiqd-projectkit/internal/app/projectkit/action"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+type WorkflowExecutionsCmd struct {
+	Workflow string `help:"Only list the executions of the workflow with this ID."`
+	JSON     bool   `help:"Print JSON instead of a table." name:"json"`
+}
+
+func (cmd *WorkflowExecutionsCmd) Run(workflowRepository workflowAPI.Repository) error {
+	return action.NewListExecutionsAction(workflowRepository, workflowAPI.WorkflowId(cmd.Workflow), os.Stdout, cmd.JSON).Run()
+}
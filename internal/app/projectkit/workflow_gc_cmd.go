@@ -0,0 +1,21 @@
+package projectkit
+
+import (
+	"github.com/coder/quartz"
+	"github.com/orbiqd/orbiqd-projectkit/internal/app/projectkit/action"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+	projectAPI "github.com/orbiqd/orbiqd-projectkit/pkg/project"
+)
+
+type WorkflowGCCmd struct {
+	DryRun bool `help:"Only log the executions that would be removed." name:"dry-run"`
+}
+
+func (cmd *WorkflowGCCmd) Run(config *projectAPI.Config, workflowRepository workflowAPI.Repository) error {
+	var retention *workflowAPI.RetentionConfig
+	if config.AI != nil && config.AI.Workflows != nil {
+		retention = config.AI.Workflows.Retention
+	}
+
+	return action.NewGCExecutionsAction(workflowRepository, retention, quartz.NewReal(), cmd.DryRun).Run()
+}
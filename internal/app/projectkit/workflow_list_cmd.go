@@ -0,0 +1,16 @@
+package projectkit
+
+import (
+	"os"
+
+	"github.com/orbiqd/orbiqd-projectkit/internal/app/projectkit/action"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+type WorkflowListCmd struct {
+	JSON bool `help:"Print JSON instead of a table." name:"json"`
+}
+
+func (cmd *WorkflowListCmd) Run(workflowRepository workflowAPI.Repository) error {
+	return action.NewListWorkflowsAction(workflowRepository, os.Stdout, cmd.JSON).Run()
+}
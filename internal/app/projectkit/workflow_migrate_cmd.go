@@ -0,0 +1,15 @@
+package projectkit
+
+import (
+	"github.com/orbiqd/orbiqd-projectkit/internal/app/projectkit/action"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+type WorkflowMigrateCmd struct {
+	ExecutionId string            `arg:"" help:"ID of the workflow execution."`
+	Step        map[string]string `help:"Maps a step ID of the version the execution runs to a step ID of the current version, as old=new. Steps not mapped keep their ID."`
+}
+
+func (cmd *WorkflowMigrateCmd) Run(workflowService workflowAPI.Service) error {
+	return action.NewMigrateExecutionAction(workflowService, workflowAPI.ExecutionId(cmd.ExecutionId), cmd.Step).Run()
+}
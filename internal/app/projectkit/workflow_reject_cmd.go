@@ -0,0 +1,15 @@
+package projectkit
+
+import (
+	"github.com/orbiqd/orbiqd-projectkit/internal/app/projectkit/action"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+type WorkflowRejectCmd struct {
+	ExecutionId string `arg:"" help:"ID of the workflow execution."`
+	Reason      string `help:"Why the execution was rejected, passed back to the agent." required:""`
+}
+
+func (cmd *WorkflowRejectCmd) Run(workflowService workflowAPI.Service) error {
+	return action.NewRejectExecutionAction(workflowService, workflowAPI.ExecutionId(cmd.ExecutionId), cmd.Reason).Run()
+}
@@ -0,0 +1,35 @@
+package projectkit
+
+import (
+	"github.com/orbiqd/orbiqd-projectkit/internal/app/projectkit/action"
+	"github.com/orbiqd/orbiqd-projectkit/internal/pkg/ai/workflow"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+	projectAPI "github.com/orbiqd/orbiqd-projectkit/pkg/project"
+)
+
+type WorkflowRenderCmd struct{}
+
+func (cmd *WorkflowRenderCmd) Run(
+	config *projectAPI.Config,
+	projectFs projectAPI.Fs,
+	workflowRepository workflowAPI.Repository,
+) error {
+	return newRenderDocWorkflowAction(config, projectFs, workflowRepository).Run()
+}
+
+func newRenderDocWorkflowAction(
+	config *projectAPI.Config,
+	projectFs projectAPI.Fs,
+	workflowRepository workflowAPI.Repository,
+) *action.RenderDocWorkflowAction {
+	var renderConfigs []workflowAPI.RenderConfig
+	if config.AI != nil && config.AI.Workflows != nil {
+		renderConfigs = config.AI.Workflows.Render
+	}
+
+	renderers := map[string]workflowAPI.Renderer{
+		"markdown": workflow.NewMarkdownRenderer(),
+		"mermaid":  workflow.NewMermaidRenderer(),
+	}
+	return action.NewRenderDocWorkflowAction(workflowRepository, projectFs, renderConfigs, renderers)
+}
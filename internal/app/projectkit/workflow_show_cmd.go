@@ -0,0 +1,17 @@
+package projectkit
+
+import (
+	"os"
+
+	"github.com/orbiqd/orbiqd-projectkit/internal/app/projectkit/action"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+type WorkflowShowCmd struct {
+	WorkflowId string `arg:"" help:"ID of the workflow."`
+	JSON       bool   `help:"Print JSON instead of a table." name:"json"`
+}
+
+func (cmd *WorkflowShowCmd) Run(workflowRepository workflowAPI.Repository) error {
+	return action.NewShowWorkflowAction(workflowRepository, workflowAPI.WorkflowId(cmd.WorkflowId), os.Stdout, cmd.JSON).Run()
+}
@@ -0,0 +1,17 @@
+package projectkit
+
+import (
+	"os"
+
+	"github.com/orbiqd/orbiqd-projectkit/internal/app/projectkit/action"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+type WorkflowStartCmd struct {
+	WorkflowId string `arg:"" help:"ID of the workflow to start."`
+	JSON       bool   `help:"Print JSON instead of a table." name:"json"`
+}
+
+func (cmd *WorkflowStartCmd) Run(workflowRepository workflowAPI.Repository, workflowService workflowAPI.Service) error {
+	return action.NewStartWorkflowAction(workflowRepository, workflowService, workflowAPI.WorkflowId(cmd.WorkflowId), os.Stdout, cmd.JSON).Run()
+}
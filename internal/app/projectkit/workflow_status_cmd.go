@@ -0,0 +1,17 @@
+package projectkit
+
+import (
+	"os"
+
+	"github.com/orbiqd/orbiqd-projectkit/internal/app/projectkit/action"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+type WorkflowStatusCmd struct {
+	ExecutionId string `arg:"" help:"ID of the workflow execution."`
+	JSON        bool   `help:"Print JSON instead of a table." name:"json"`
+}
+
+func (cmd *WorkflowStatusCmd) Run(workflowRepository workflowAPI.Repository, workflowService workflowAPI.Service) error {
+	return action.NewShowExecutionAction(workflowRepository, workflowService, workflowAPI.ExecutionId(cmd.ExecutionId), os.Stdout, cmd.JSON).Run()
+}
@@ -3,15 +3,19 @@ package claude
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 	"path"
 	"strings"
 
 	"github.com/creasty/defaults"
 	"github.com/iancoleman/strcase"
+	workflowInternal "github.com/orbiqd/orbiqd-projectkit/internal/pkg/ai/workflow"
 	agentAPI "github.com/orbiqd/orbiqd-projectkit/pkg/agent"
 	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	mcpAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
 	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
 	"github.com/spf13/afero"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -19,6 +23,10 @@ import (
 
 const Kind = "claude"
 
+// workflowCommandMarker marks the command files rendered from workflows, so that they can be told apart from
+// commands written by hand.
+const workflowCommandMarker = "<!-- Rendered by projectkit from a workflow, changes will be overwritten. -->"
+
 type Agent struct {
 	options Options
 	rootFs  afero.Fs
@@ -138,6 +146,236 @@ func (agent *Agent) RenderMCPServers(mcpServers []mcpAPI.MCPServer) error {
 	return nil
 }
 
+// RenderTools writes the tool commands as Bash permission rules to the "allow" list of the project
+// settings file. The rules rendered last time are listed in a separate file next to the settings, so that only
+// they are replaced; rules written by hand and other settings, including the remaining permission lists, are
+// preserved.
+func (agent *Agent) RenderTools(tools []toolAPI.Tool) error {
+	settingsPath := path.Join(agent.options.ProjectSettingsDirName, agent.options.SettingsFileName)
+
+	settings := make(map[string]any)
+	exists := true
+
+	data, err := afero.ReadFile(agent.rootFs, settingsPath)
+	switch {
+	case os.IsNotExist(err):
+		exists = false
+	case err != nil:
+		return fmt.Errorf("settings file read: %w", err)
+	default:
+		if err := json.Unmarshal(data, &settings); err != nil {
+			return fmt.Errorf("settings file parse: %w", err)
+		}
+	}
+
+	previouslyRendered, err := agent.loadRenderedTools()
+	if err != nil {
+		return err
+	}
+
+	permissions, _ := settings["permissions"].(map[string]any)
+	if permissions == nil {
+		permissions = make(map[string]any)
+	}
+
+	existingAllow, hasAllow := permissions["allow"].([]any)
+
+	allow := []any{}
+	kept := make(map[string]bool)
+	for _, entry := range existingAllow {
+		rule, isRule := entry.(string)
+		if isRule && previouslyRendered[rule] {
+			continue
+		}
+		if isRule {
+			kept[rule] = true
+		}
+
+		allow = append(allow, entry)
+	}
+
+	var rendered []string
+	for _, tool := range tools {
+		for _, command := range tool.Definition.Commands {
+			rule := fmt.Sprintf("Bash(%s:*)", command)
+			if kept[rule] {
+				continue
+			}
+
+			kept[rule] = true
+			rendered = append(rendered, rule)
+			allow = append(allow, rule)
+		}
+	}
+
+	if hasAllow || len(allow) > 0 {
+		permissions["allow"] = allow
+		settings["permissions"] = permissions
+	}
+
+	if !exists && len(settings) == 0 {
+		return agent.saveRenderedTools(rendered)
+	}
+
+	err = agent.rootFs.MkdirAll(agent.options.ProjectSettingsDirName, 0755)
+	if err != nil {
+		return fmt.Errorf("settings directory creation: %w", err)
+	}
+
+	data, err = json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return fmt.Errorf("settings serialization: %w", err)
+	}
+
+	data = append(data, '\n')
+
+	err = afero.WriteFile(agent.rootFs, settingsPath, data, 0644)
+	if err != nil {
+		return fmt.Errorf("settings file write: %w", err)
+	}
+
+	return agent.saveRenderedTools(rendered)
+}
+
+// loadRenderedTools returns the permission rules rendered from tools by the previous RenderTools call.
+func (agent *Agent) loadRenderedTools() (map[string]bool, error) {
+	renderedPath := path.Join(agent.options.ProjectSettingsDirName, agent.options.RenderedToolsFileName)
+
+	data, err := afero.ReadFile(agent.rootFs, renderedPath)
+	switch {
+	case os.IsNotExist(err):
+		return map[string]bool{}, nil
+	case err != nil:
+		return nil, fmt.Errorf("rendered tools file read: %w", err)
+	}
+
+	var rules []string
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("rendered tools file parse: %w", err)
+	}
+
+	rendered := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		rendered[rule] = true
+	}
+
+	return rendered, nil
+}
+
+// saveRenderedTools records the permission rules rendered from tools, removing the record when there are none.
+func (agent *Agent) saveRenderedTools(rules []string) error {
+	renderedPath := path.Join(agent.options.ProjectSettingsDirName, agent.options.RenderedToolsFileName)
+
+	if len(rules) == 0 {
+		err := agent.rootFs.Remove(renderedPath)
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("rendered tools file removal: %w", err)
+		}
+
+		return nil
+	}
+
+	data, err := json.MarshalIndent(rules, "", "  ")
+	if err != nil {
+		return fmt.Errorf("rendered tools serialization: %w", err)
+	}
+
+	data = append(data, '\n')
+
+	err = afero.WriteFile(agent.rootFs, renderedPath, data, 0644)
+	if err != nil {
+		return fmt.Errorf("rendered tools file write: %w", err)
+	}
+
+	return nil
+}
+
+// RenderWorkflows writes a slash command for every workflow to the project commands directory, e.g.
+// "/example" for the workflow with ID "example". Commands rendered for workflows that no longer exist are
+// removed, commands written by hand are left alone.
+func (agent *Agent) RenderWorkflows(workflows []workflowAPI.Workflow) error {
+	commandsDir := path.Join(agent.options.ProjectSettingsDirName, agent.options.CommandsDirName)
+
+	rendered, err := agent.renderedWorkflowCommands(commandsDir)
+	if err != nil {
+		return err
+	}
+
+	for _, commandPath := range rendered {
+		err := agent.rootFs.Remove(commandPath)
+		if err != nil {
+			return fmt.Errorf("workflow command file removal: %w", err)
+		}
+	}
+
+	if len(workflows) == 0 {
+		return nil
+	}
+
+	err = agent.rootFs.MkdirAll(commandsDir, 0755)
+	if err != nil {
+		return fmt.Errorf("commands directory creation: %w", err)
+	}
+
+	renderer := workflowInternal.NewPromptRenderer(func(tool string) string {
+		return "`mcp__projectkit__" + tool + "`"
+	}, workflowCommandMarker)
+
+	for _, workflow := range workflows {
+		commandPath := path.Join(commandsDir, string(workflow.Metadata.ID)+".md")
+
+		exists, err := afero.Exists(agent.rootFs, commandPath)
+		if err != nil {
+			return fmt.Errorf("workflow command file check: %w", err)
+		}
+		if exists {
+			return fmt.Errorf("workflow %s: command file %s was not rendered by projectkit", workflow.Metadata.ID, commandPath)
+		}
+
+		content, err := renderer.Render(workflow)
+		if err != nil {
+			return fmt.Errorf("workflow %s command rendering: %w", workflow.Metadata.ID, err)
+		}
+
+		err = afero.WriteFile(agent.rootFs, commandPath, content, 0644)
+		if err != nil {
+			return fmt.Errorf("workflow command file write: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// renderedWorkflowCommands returns the paths of the command files previously rendered from workflows.
+func (agent *Agent) renderedWorkflowCommands(commandsDir string) ([]string, error) {
+	entries, err := afero.ReadDir(agent.rootFs, commandsDir)
+	switch {
+	case os.IsNotExist(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("commands directory read: %w", err)
+	}
+
+	var rendered []string
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".md" {
+			continue
+		}
+
+		commandPath := path.Join(commandsDir, entry.Name())
+		data, err := afero.ReadFile(agent.rootFs, commandPath)
+		if err != nil {
+			return nil, fmt.Errorf("command file read: %w", err)
+		}
+
+		if strings.Contains(string(data), workflowCommandMarker) {
+			rendered = append(rendered, commandPath)
+		}
+	}
+
+	return rendered, nil
+}
+
 func (agent *Agent) renderSkill(skillsDir string, skill skillAPI.Skill) error {
 	skillDir := path.Join(skillsDir, string(skill.Metadata.Name))
 
@@ -4,12 +4,15 @@ import (
 	"encoding/json"
 	"errors"
 	"io/fs"
+	"strings"
 	"testing"
 
 	agentAPI "github.com/orbiqd/orbiqd-projectkit/pkg/agent"
 	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	mcpAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
 	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -815,3 +818,267 @@ func TestAgent_RenderMCPServers_WhenCalledTwice_ThenOverwritesPreviousContent(t
 	assert.Contains(t, config.MCPServers, "server-two")
 	assert.NotContains(t, config.MCPServers, "server-one")
 }
+
+func testTools() []toolAPI.Tool {
+	return []toolAPI.Tool{
+		{
+			Metadata:   toolAPI.Metadata{ID: "go-test", Name: "Go Test", Description: "Runs go tests."},
+			Definition: toolAPI.Definition{Commands: []toolAPI.Command{"go test"}},
+		},
+		{
+			Metadata:   toolAPI.Metadata{ID: "go-vet", Name: "Go Vet", Description: "Vets go code."},
+			Definition: toolAPI.Definition{Commands: []toolAPI.Command{"go vet", "go test"}},
+		},
+	}
+}
+
+func TestAgent_RenderTools_WhenToolsProvided_ThenWritesAllowedCommands(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	agent := NewAgent(Options{}, fs)
+
+	err := agent.RenderTools(testTools())
+	require.NoError(t, err)
+
+	content, err := afero.ReadFile(fs, ".claude/settings.json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"permissions":{"allow":["Bash(go test:*)","Bash(go vet:*)"]}}`, string(content))
+}
+
+func TestAgent_RenderTools_WhenSettingsExist_ThenPreservesOtherSettings(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, ".claude/settings.json", []byte(`{
+  "model": "opus",
+  "permissions": {
+    "allow": ["Bash(rm:*)"],
+    "deny": ["Read(./.env)"]
+  }
+}`), 0644))
+	agent := NewAgent(Options{}, fs)
+
+	err := agent.RenderTools(testTools()[:1])
+	require.NoError(t, err)
+
+	content, err := afero.ReadFile(fs, ".claude/settings.json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+  "model": "opus",
+  "permissions": {
+    "allow": ["Bash(rm:*)", "Bash(go test:*)"],
+    "deny": ["Read(./.env)"]
+  }
+}`, string(content))
+}
+
+func TestAgent_RenderTools_WhenRenderedAgain_ThenReplacesOnlyRenderedRules(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, ".claude/settings.json", []byte(`{
+  "permissions": {
+    "allow": ["Bash(make:*)", "WebFetch"]
+  }
+}`), 0644))
+	agent := NewAgent(Options{}, fs)
+	require.NoError(t, agent.RenderTools(testTools()))
+
+	err := agent.RenderTools(testTools()[:1])
+	require.NoError(t, err)
+
+	content, err := afero.ReadFile(fs, ".claude/settings.json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"permissions":{"allow":["Bash(make:*)","WebFetch","Bash(go test:*)"]}}`, string(content))
+
+	rendered, err := afero.ReadFile(fs, ".claude/projectkit-tools.json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `["Bash(go test:*)"]`, string(rendered))
+}
+
+func TestAgent_RenderTools_WhenUserRuleMatchesTool_ThenKeepsUserRuleAfterToolRemoved(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, ".claude/settings.json", []byte(`{"permissions":{"allow":["Bash(go test:*)"]}}`), 0644))
+	agent := NewAgent(Options{}, fs)
+	require.NoError(t, agent.RenderTools(testTools()))
+
+	err := agent.RenderTools([]toolAPI.Tool{})
+	require.NoError(t, err)
+
+	content, err := afero.ReadFile(fs, ".claude/settings.json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"permissions":{"allow":["Bash(go test:*)"]}}`, string(content))
+}
+
+func TestAgent_RenderTools_WhenNoToolsAndNoSettings_ThenDoesNotCreateFile(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	agent := NewAgent(Options{}, fs)
+
+	err := agent.RenderTools([]toolAPI.Tool{})
+	require.NoError(t, err)
+
+	exists, err := afero.Exists(fs, ".claude/settings.json")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestAgent_RenderTools_WhenNoTools_ThenEmptiesAllowListAndForgetsRenderedRules(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	agent := NewAgent(Options{}, fs)
+	require.NoError(t, agent.RenderTools(testTools()))
+
+	err := agent.RenderTools([]toolAPI.Tool{})
+	require.NoError(t, err)
+
+	content, err := afero.ReadFile(fs, ".claude/settings.json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"permissions":{"allow":[]}}`, string(content))
+
+	exists, err := afero.Exists(fs, ".claude/projectkit-tools.json")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestAgent_RenderTools_WhenSettingsInvalid_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, ".claude/settings.json", []byte("not json"), 0644))
+	agent := NewAgent(Options{}, fs)
+
+	err := agent.RenderTools(testTools())
+
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "settings file parse")
+}
+
+func TestAgent_RenderTools_WhenFileSystemFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewReadOnlyFs(afero.NewMemMapFs())
+	agent := NewAgent(Options{}, fs)
+
+	err := agent.RenderTools(testTools())
+
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "settings directory creation")
+}
+
+func testWorkflows() []workflowAPI.Workflow {
+	return []workflowAPI.Workflow{
+		{
+			Metadata: workflowAPI.Metadata{ID: "example", Name: "Example", Description: "Adds two numbers.", Version: "0.1.0"},
+			Steps: []workflowAPI.Step{
+				{ID: "prepare", Name: "Prepare", Description: "Pick two numbers."},
+				{ID: "add", Name: "Add", Description: "Add the numbers."},
+			},
+		},
+	}
+}
+
+func TestAgent_RenderWorkflows_WhenWorkflowsProvided_ThenWritesSlashCommands(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	agent := NewAgent(Options{}, fs)
+
+	err := agent.RenderWorkflows(testWorkflows())
+	require.NoError(t, err)
+
+	content, err := afero.ReadFile(fs, ".claude/commands/example.md")
+	require.NoError(t, err)
+
+	expectedContent := `---
+argument-hint: '[context]'
+description: Adds two numbers.
+---
+
+<!-- Rendered by projectkit from a workflow, changes will be overwritten. -->
+
+Follow the "Example" workflow (` + "`example`" + `, version 0.1.0) step by step using the tools of the ` + "`projectkit`" + ` MCP server.
+
+Steps:
+
+1. Prepare - Pick two numbers.
+2. Add - Add the numbers.
+
+How to follow the workflow:
+
+`
+	assert.True(t, strings.HasPrefix(string(content), expectedContent), string(content))
+	assert.Contains(t, string(content), "`mcp__projectkit__workflow_start`, passing `workflowId` set to `example`")
+	assert.True(t, strings.HasSuffix(string(content), "Context from the user: $ARGUMENTS\n"))
+}
+
+func TestAgent_RenderWorkflows_WhenWorkflowRemoved_ThenRemovesOnlyRenderedCommands(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	agent := NewAgent(Options{}, fs)
+	require.NoError(t, afero.WriteFile(fs, ".claude/commands/review.md", []byte("Review the diff."), 0644))
+
+	require.NoError(t, agent.RenderWorkflows(testWorkflows()))
+
+	err := agent.RenderWorkflows(nil)
+	require.NoError(t, err)
+
+	exists, err := afero.Exists(fs, ".claude/commands/example.md")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	content, err := afero.ReadFile(fs, ".claude/commands/review.md")
+	require.NoError(t, err)
+	assert.Equal(t, "Review the diff.", string(content))
+}
+
+func TestAgent_RenderWorkflows_WhenCommandWrittenByHand_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	agent := NewAgent(Options{}, fs)
+	require.NoError(t, afero.WriteFile(fs, ".claude/commands/example.md", []byte("My own example."), 0644))
+
+	err := agent.RenderWorkflows(testWorkflows())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "was not rendered by projectkit")
+
+	content, err := afero.ReadFile(fs, ".claude/commands/example.md")
+	require.NoError(t, err)
+	assert.Equal(t, "My own example.", string(content))
+}
+
+func TestAgent_RenderWorkflows_WhenNoWorkflows_ThenDoesNotCreateDirectory(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	agent := NewAgent(Options{}, fs)
+
+	err := agent.RenderWorkflows(nil)
+	require.NoError(t, err)
+
+	exists, err := afero.DirExists(fs, ".claude/commands")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestAgent_RenderWorkflows_WhenCustomCommandsDir_ThenUsesCustomPath(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	agent := NewAgent(Options{CommandsDirName: "workflows"}, fs)
+
+	err := agent.RenderWorkflows(testWorkflows())
+	require.NoError(t, err)
+
+	exists, err := afero.Exists(fs, ".claude/workflows/example.md")
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
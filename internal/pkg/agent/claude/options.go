@@ -4,5 +4,8 @@ type Options struct {
 	InstructionsFileName   string `json:"instructionsFileName" validate:"required" default:"CLAUDE.md"`
 	ProjectSettingsDirName string `json:"projectSettingsDirName" validate:"required" default:".claude"`
 	SkillsDirName          string `json:"skillsDirName" validate:"required" default:"skills"`
+	CommandsDirName        string `json:"commandsDirName" validate:"required" default:"commands"`
 	MCPFileName            string `json:"mcpFileName" validate:"required" default:".mcp.json"`
+	SettingsFileName       string `json:"settingsFileName" validate:"required" default:"settings.json"`
+	RenderedToolsFileName  string `json:"renderedToolsFileName" validate:"required" default:"projectkit-tools.json"`
 }
@@ -2,15 +2,19 @@ package codex
 
 import (
 	"fmt"
+	"os"
 	"path"
 	"strings"
 
 	"github.com/creasty/defaults"
 	"github.com/iancoleman/strcase"
+	workflowInternal "github.com/orbiqd/orbiqd-projectkit/internal/pkg/ai/workflow"
 	agentAPI "github.com/orbiqd/orbiqd-projectkit/pkg/agent"
 	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	mcpAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
 	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
 	"github.com/spf13/afero"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -18,6 +22,10 @@ import (
 
 const Kind = "codex"
 
+// workflowPromptMarker marks the prompt files rendered from workflows, so that they can be told apart from
+// prompts written by hand.
+const workflowPromptMarker = "<!-- Rendered by projectkit from a workflow, changes will be overwritten. -->"
+
 type Agent struct {
 	options Options
 	rootFs  afero.Fs
@@ -93,6 +101,96 @@ func (agent *Agent) RenderMCPServers(mcpServers []mcpAPI.MCPServer) error {
 	return nil
 }
 
+// RenderTools is a no-op, Codex has no project level command allow list.
+func (agent *Agent) RenderTools(tools []toolAPI.Tool) error {
+	return nil
+}
+
+// RenderWorkflows renders a custom prompt for every workflow, named after the workflow ID. Prompts rendered by a
+// previous run are replaced, prompts written by hand are left in place.
+func (agent *Agent) RenderWorkflows(workflows []workflowAPI.Workflow) error {
+	promptsDir := path.Join(agent.options.ProjectSettingsDirName, agent.options.PromptsDirName)
+
+	rendered, err := agent.renderedWorkflowPrompts(promptsDir)
+	if err != nil {
+		return err
+	}
+
+	for _, promptPath := range rendered {
+		err := agent.rootFs.Remove(promptPath)
+		if err != nil {
+			return fmt.Errorf("workflow prompt file removal: %w", err)
+		}
+	}
+
+	if len(workflows) == 0 {
+		return nil
+	}
+
+	err = agent.rootFs.MkdirAll(promptsDir, 0755)
+	if err != nil {
+		return fmt.Errorf("prompts directory creation: %w", err)
+	}
+
+	renderer := workflowInternal.NewPromptRenderer(func(tool string) string {
+		return "the `" + tool + "` tool"
+	}, workflowPromptMarker)
+
+	for _, workflow := range workflows {
+		promptPath := path.Join(promptsDir, string(workflow.Metadata.ID)+".md")
+
+		exists, err := afero.Exists(agent.rootFs, promptPath)
+		if err != nil {
+			return fmt.Errorf("workflow prompt file check: %w", err)
+		}
+		if exists {
+			return fmt.Errorf("workflow %s: prompt file %s was not rendered by projectkit", workflow.Metadata.ID, promptPath)
+		}
+
+		content, err := renderer.Render(workflow)
+		if err != nil {
+			return fmt.Errorf("workflow %s prompt rendering: %w", workflow.Metadata.ID, err)
+		}
+
+		err = afero.WriteFile(agent.rootFs, promptPath, content, 0644)
+		if err != nil {
+			return fmt.Errorf("workflow prompt file write: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// renderedWorkflowPrompts returns the paths of the prompt files previously rendered from workflows.
+func (agent *Agent) renderedWorkflowPrompts(promptsDir string) ([]string, error) {
+	entries, err := afero.ReadDir(agent.rootFs, promptsDir)
+	switch {
+	case os.IsNotExist(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("prompts directory read: %w", err)
+	}
+
+	var rendered []string
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".md" {
+			continue
+		}
+
+		promptPath := path.Join(promptsDir, entry.Name())
+		data, err := afero.ReadFile(agent.rootFs, promptPath)
+		if err != nil {
+			return nil, fmt.Errorf("prompt file read: %w", err)
+		}
+
+		if strings.Contains(string(data), workflowPromptMarker) {
+			rendered = append(rendered, promptPath)
+		}
+	}
+
+	return rendered, nil
+}
+
 func (agent *Agent) renderSkill(skillsDir string, skill skillAPI.Skill) error {
 	skillDir := path.Join(skillsDir, string(skill.Metadata.Name))
 
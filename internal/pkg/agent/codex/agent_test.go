@@ -3,10 +3,12 @@ package codex
 import (
 	"errors"
 	"io/fs"
+	"strings"
 	"testing"
 
 	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -583,3 +585,93 @@ func TestAgent_RebuildSkills_WhenScriptFileWriteFails_ThenReturnsError(t *testin
 	require.Error(t, err)
 	assert.ErrorContains(t, err, "script file write")
 }
+
+func testWorkflows() []workflowAPI.Workflow {
+	return []workflowAPI.Workflow{
+		{
+			Metadata: workflowAPI.Metadata{ID: "example", Name: "Example", Description: "Adds two numbers.", Version: "0.1.0"},
+			Steps:    []workflowAPI.Step{{ID: "prepare", Name: "Prepare", Description: "Pick two numbers."}},
+		},
+	}
+}
+
+func TestAgent_RenderWorkflows_WhenWorkflowsProvided_ThenRebuildsPrompts(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	agent := NewAgent(Options{}, fs)
+	require.NoError(t, afero.WriteFile(fs, ".agents/prompts/removed.md", []byte("Stale prompt.\n"+workflowPromptMarker+"\n"), 0644))
+
+	err := agent.RenderWorkflows(testWorkflows())
+	require.NoError(t, err)
+
+	content, err := afero.ReadFile(fs, ".agents/prompts/example.md")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(content), "---\nargument-hint: '[context]'\ndescription: Adds two numbers.\n---\n\n"+workflowPromptMarker+"\n\n"), string(content))
+	assert.Contains(t, string(content), "1. Prepare - Pick two numbers.\n")
+	assert.Contains(t, string(content), "`workflow_start` tool, passing `workflowId` set to `example`")
+
+	exists, err := afero.Exists(fs, ".agents/prompts/removed.md")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestAgent_RenderWorkflows_WhenNoWorkflows_ThenDoesNotCreateDirectory(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	agent := NewAgent(Options{}, fs)
+
+	err := agent.RenderWorkflows(nil)
+	require.NoError(t, err)
+
+	exists, err := afero.DirExists(fs, ".agents/prompts")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestAgent_RenderWorkflows_WhenPromptWrittenByHand_ThenKeepsIt(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	agent := NewAgent(Options{}, fs)
+	require.NoError(t, afero.WriteFile(fs, ".agents/prompts/review.md", []byte("Review the diff."), 0644))
+
+	err := agent.RenderWorkflows(testWorkflows())
+	require.NoError(t, err)
+
+	content, err := afero.ReadFile(fs, ".agents/prompts/review.md")
+	require.NoError(t, err)
+	assert.Equal(t, "Review the diff.", string(content))
+
+	exists, err := afero.Exists(fs, ".agents/prompts/example.md")
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestAgent_RenderWorkflows_WhenRenderedAgain_ThenReplacesRenderedPrompt(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	agent := NewAgent(Options{}, fs)
+
+	require.NoError(t, agent.RenderWorkflows(testWorkflows()))
+	require.NoError(t, agent.RenderWorkflows(testWorkflows()))
+
+	exists, err := afero.Exists(fs, ".agents/prompts/example.md")
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestAgent_RenderWorkflows_WhenPromptWrittenByHandSharesWorkflowId_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	agent := NewAgent(Options{}, fs)
+	require.NoError(t, afero.WriteFile(fs, ".agents/prompts/example.md", []byte("My own example."), 0644))
+
+	err := agent.RenderWorkflows(testWorkflows())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "was not rendered by projectkit")
+}
@@ -4,4 +4,5 @@ type Options struct {
 	InstructionsFileName   string `json:"instructionsFileName" validate:"required" default:"AGENTS.md"`
 	ProjectSettingsDirName string `json:"projectSettingsDirName" validate:"required" default:".agents"`
 	SkillsDirName          string `json:"skillsDirName" validate:"required" default:"skills"`
+	PromptsDirName         string `json:"promptsDirName" validate:"required" default:"prompts"`
 }
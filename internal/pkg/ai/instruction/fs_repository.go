@@ -120,6 +120,7 @@ func (repository *FsRepository) AddInstructions(instructions instructionAPI.Inst
 
 		if existing.Category == instructions.Category {
 			existing.Rules = append(existing.Rules, instructions.Rules...)
+			existing.Provenance = append(existing.Provenance, instructions.Provenance...)
 			return repository.saveFile(file, existing)
 		}
 	}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -483,3 +484,27 @@ func TestFsRepository_RemoveAll_WhenRemoveFails_ThenReturnsError(t *testing.T) {
 
 	require.ErrorIs(t, err, removeErr)
 }
+
+func TestFsRepository_AddInstructions_WhenSameCategoryAddedTwice_ThenMergesProvenance(t *testing.T) {
+	t.Parallel()
+
+	repo := NewFsRepository(afero.NewMemMapFs())
+	first := sourceAPI.Provenance{SourceURI: "local://./rulebooks/general", RulebookName: "general", RulebookVersion: "1.0.0", Path: "ai/instructions/coding.yaml"}
+	second := sourceAPI.Provenance{SourceURI: "local://./instructions", Path: "coding.yaml"}
+
+	require.NoError(t, repo.AddInstructions(instructionAPI.Instructions{
+		Category:   "coding",
+		Rules:      []instructionAPI.Rule{"rule1"},
+		Provenance: []sourceAPI.Provenance{first},
+	}))
+	require.NoError(t, repo.AddInstructions(instructionAPI.Instructions{
+		Category:   "coding",
+		Rules:      []instructionAPI.Rule{"rule2"},
+		Provenance: []sourceAPI.Provenance{second},
+	}))
+
+	result, err := repo.GetAll()
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, []sourceAPI.Provenance{first, second}, result[0].Provenance)
+}
@@ -4,12 +4,14 @@ import (
 	"sync"
 
 	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
 )
 
 // MemoryRepository stores instructions in memory.
 type MemoryRepository struct {
 	mutex      sync.RWMutex
 	categories map[instructionAPI.Category][]instructionAPI.Rule
+	provenance map[instructionAPI.Category][]sourceAPI.Provenance
 }
 
 var _ instructionAPI.Repository = (*MemoryRepository)(nil)
@@ -19,6 +21,7 @@ func NewMemoryRepository() *MemoryRepository {
 	return &MemoryRepository{
 		mutex:      sync.RWMutex{},
 		categories: make(map[instructionAPI.Category][]instructionAPI.Rule),
+		provenance: make(map[instructionAPI.Category][]sourceAPI.Provenance),
 	}
 }
 
@@ -30,8 +33,9 @@ func (repository *MemoryRepository) GetAll() ([]instructionAPI.Instructions, err
 	result := make([]instructionAPI.Instructions, 0, len(repository.categories))
 	for category, rules := range repository.categories {
 		result = append(result, instructionAPI.Instructions{
-			Category: category,
-			Rules:    rules,
+			Category:   category,
+			Rules:      rules,
+			Provenance: repository.provenance[category],
 		})
 	}
 
@@ -44,6 +48,7 @@ func (repository *MemoryRepository) AddInstructions(instructions instructionAPI.
 	defer repository.mutex.Unlock()
 
 	repository.categories[instructions.Category] = append(repository.categories[instructions.Category], instructions.Rules...)
+	repository.provenance[instructions.Category] = append(repository.provenance[instructions.Category], instructions.Provenance...)
 
 	return nil
 }
@@ -54,6 +59,7 @@ func (repository *MemoryRepository) RemoveAll() error {
 	defer repository.mutex.Unlock()
 
 	repository.categories = make(map[instructionAPI.Category][]instructionAPI.Rule)
+	repository.provenance = make(map[instructionAPI.Category][]sourceAPI.Provenance)
 
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -223,3 +224,27 @@ func TestMemoryRepository_RemoveAll_WhenFollowedByAdd_ThenAcceptsNewInstructions
 	assert.Equal(t, instructionAPI.Category("new-category"), result[0].Category)
 	assert.Equal(t, []instructionAPI.Rule{"new-rule1", "new-rule2"}, result[0].Rules)
 }
+
+func TestMemoryRepository_AddInstructions_WhenSameCategoryAddedTwice_ThenMergesProvenance(t *testing.T) {
+	t.Parallel()
+
+	repo := NewMemoryRepository()
+	first := sourceAPI.Provenance{SourceURI: "local://./rulebooks/general", RulebookName: "general", RulebookVersion: "1.0.0", Path: "ai/instructions/coding.yaml"}
+	second := sourceAPI.Provenance{SourceURI: "local://./instructions", Path: "coding.yaml"}
+
+	require.NoError(t, repo.AddInstructions(instructionAPI.Instructions{
+		Category:   "coding",
+		Rules:      []instructionAPI.Rule{"rule1"},
+		Provenance: []sourceAPI.Provenance{first},
+	}))
+	require.NoError(t, repo.AddInstructions(instructionAPI.Instructions{
+		Category:   "coding",
+		Rules:      []instructionAPI.Rule{"rule2"},
+		Provenance: []sourceAPI.Provenance{second},
+	}))
+
+	result, err := repo.GetAll()
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, []sourceAPI.Provenance{first, second}, result[0].Provenance)
+}
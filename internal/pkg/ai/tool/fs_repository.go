@@ -0,0 +1,124 @@
+package tool
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	"github.com/spf13/afero"
+)
+
+type FsRepository struct {
+	mutex sync.RWMutex
+	fs    afero.Fs
+}
+
+var _ toolAPI.Repository = (*FsRepository)(nil)
+
+func NewFsRepository(fs afero.Fs) *FsRepository {
+	return &FsRepository{
+		mutex: sync.RWMutex{},
+		fs:    fs,
+	}
+}
+
+func (repository *FsRepository) listFiles() ([]string, error) {
+	entries, err := afero.ReadDir(repository.fs, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if strings.ToLower(filepath.Ext(entry.Name())) == ".json" {
+			files = append(files, entry.Name())
+		}
+	}
+
+	return files, nil
+}
+
+func (repository *FsRepository) loadFile(filename string) (toolAPI.Tool, error) {
+	data, err := afero.ReadFile(repository.fs, filename)
+	if err != nil {
+		return toolAPI.Tool{}, err
+	}
+
+	var tool toolAPI.Tool
+	if err := json.Unmarshal(data, &tool); err != nil {
+		return toolAPI.Tool{}, err
+	}
+
+	return tool, nil
+}
+
+func (repository *FsRepository) saveFile(filename string, tool toolAPI.Tool) error {
+	data, err := json.Marshal(tool)
+	if err != nil {
+		return err
+	}
+
+	return afero.WriteFile(repository.fs, filename, data, 0644)
+}
+
+func (repository *FsRepository) GetAll() ([]toolAPI.Tool, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	files, err := repository.listFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return []toolAPI.Tool{}, nil
+	}
+
+	tools := make([]toolAPI.Tool, 0, len(files))
+	for _, file := range files {
+		tool, err := repository.loadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		tools = append(tools, tool)
+	}
+
+	sort.Slice(tools, func(i, j int) bool {
+		return tools[i].Metadata.ID < tools[j].Metadata.ID
+	})
+
+	return tools, nil
+}
+
+func (repository *FsRepository) AddTool(tool toolAPI.Tool) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	filename := uuid.NewString() + ".json"
+	return repository.saveFile(filename, tool)
+}
+
+func (repository *FsRepository) RemoveAll() error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	files, err := repository.listFiles()
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if err := repository.fs.Remove(file); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package tool
+
+import (
+	"fmt"
+
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	projectAPI "github.com/orbiqd/orbiqd-projectkit/pkg/project"
+	"github.com/spf13/afero"
+)
+
+func NewFsRepositoryProvider() func(projectAPI.Fs) (toolAPI.Repository, error) {
+	return func(projectFs projectAPI.Fs) (toolAPI.Repository, error) {
+		dir := ".projectkit/repository/ai/tool"
+
+		if err := projectFs.MkdirAll(dir, 0755); err != nil {
+			return nil, fmt.Errorf("tool repository directory creation: %w", err)
+		}
+
+		scopedFs := afero.NewBasePathFs(projectFs, dir)
+		return NewFsRepository(scopedFs), nil
+	}
+}
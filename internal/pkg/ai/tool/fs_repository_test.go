@@ -0,0 +1,143 @@
+package tool
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"testing"
+
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.nhat.io/aferomock"
+)
+
+func testTool(id string, commands ...toolAPI.Command) toolAPI.Tool {
+	return toolAPI.Tool{
+		Metadata: toolAPI.Metadata{
+			ID:          toolAPI.ToolId(id),
+			Name:        id,
+			Description: "Tool used in tests.",
+		},
+		Definition: toolAPI.Definition{
+			Commands: commands,
+		},
+	}
+}
+
+func TestFsRepository_GetAll_WhenEmpty_ThenReturnsEmptySlice(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	repo := NewFsRepository(fs)
+
+	result, err := repo.GetAll()
+
+	require.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+func TestFsRepository_GetAll_WhenMultipleTools_ThenReturnsSortedById(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	repo := NewFsRepository(fs)
+
+	require.NoError(t, repo.AddTool(testTool("go-vet", "go vet")))
+	require.NoError(t, repo.AddTool(testTool("go-test", "go test")))
+
+	result, err := repo.GetAll()
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, toolAPI.ToolId("go-test"), result[0].Metadata.ID)
+	assert.Equal(t, []toolAPI.Command{"go test"}, result[0].Definition.Commands)
+	assert.Equal(t, toolAPI.ToolId("go-vet"), result[1].Metadata.ID)
+}
+
+func TestFsRepository_GetAll_WhenReadDirFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockedErr := errors.New("read dir error")
+	mockFs := aferomock.OverrideFs(afero.NewMemMapFs(), aferomock.FsCallbacks{
+		OpenFunc: func(name string) (afero.File, error) {
+			return nil, mockedErr
+		},
+	})
+	repo := NewFsRepository(mockFs)
+
+	result, err := repo.GetAll()
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, mockedErr)
+}
+
+func TestFsRepository_GetAll_WhenUnmarshalFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	_ = afero.WriteFile(fs, "invalid.json", []byte("invalid json"), 0644)
+	repo := NewFsRepository(fs)
+
+	result, err := repo.GetAll()
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+}
+
+func TestFsRepository_AddTool_WhenValid_ThenPersistsToFs(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	repo := NewFsRepository(fs)
+
+	err := repo.AddTool(testTool("go-test", "go test"))
+	require.NoError(t, err)
+
+	files, err := afero.ReadDir(fs, ".")
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Contains(t, files[0].Name(), ".json")
+
+	data, err := afero.ReadFile(fs, files[0].Name())
+	require.NoError(t, err)
+
+	var persisted toolAPI.Tool
+	err = json.Unmarshal(data, &persisted)
+	require.NoError(t, err)
+	assert.Equal(t, toolAPI.ToolId("go-test"), persisted.Metadata.ID)
+}
+
+func TestFsRepository_AddTool_WhenWriteFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockFs := aferomock.OverrideFs(afero.NewMemMapFs(), aferomock.FsCallbacks{
+		OpenFileFunc: func(name string, flag int, perm fs.FileMode) (afero.File, error) {
+			return nil, errors.New("write error")
+		},
+	})
+	repo := NewFsRepository(mockFs)
+
+	err := repo.AddTool(testTool("go-test", "go test"))
+
+	require.Error(t, err)
+}
+
+func TestFsRepository_RemoveAll_WhenMultipleFiles_ThenRemovesAll(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	repo := NewFsRepository(fs)
+
+	_ = repo.AddTool(testTool("go-test", "go test"))
+	_ = repo.AddTool(testTool("go-vet", "go vet"))
+
+	err := repo.RemoveAll()
+	require.NoError(t, err)
+
+	result, err := repo.GetAll()
+	require.NoError(t, err)
+	assert.Empty(t, result)
+}
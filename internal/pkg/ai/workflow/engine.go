@@ -0,0 +1,622 @@
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/coder/quartz"
+	"github.com/google/uuid"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+// Engine executes workflows stored in a workflow repository, advancing executions through their steps along
+// the step transitions. Steps invoking another workflow run it as a child execution. Every change of an
+// execution is appended to its history.
+type Engine struct {
+	repository workflowAPI.Repository
+	clock      quartz.Clock
+}
+
+var _ workflowAPI.Service = (*Engine)(nil)
+
+func NewEngine(repository workflowAPI.Repository, clock quartz.Clock) *Engine {
+	return &Engine{
+		repository: repository,
+		clock:      clock,
+	}
+}
+
+func NewEngineProvider() func(workflowAPI.Repository) (workflowAPI.Service, error) {
+	return func(repository workflowAPI.Repository) (workflowAPI.Service, error) {
+		return NewEngine(repository, quartz.NewReal()), nil
+	}
+}
+
+func (engine *Engine) Execute(actor workflowAPI.Actor, workflowId workflowAPI.WorkflowId) (workflowAPI.ExecutionId, error) {
+	execution, err := engine.start(actor, workflowId, nil, map[string]any{})
+	if err != nil {
+		return "", err
+	}
+
+	return execution.Id, nil
+}
+
+// start creates an execution of the workflow with the given initial state. A sub-workflow execution is started
+// by its parent execution.
+func (engine *Engine) start(
+	actor workflowAPI.Actor,
+	workflowId workflowAPI.WorkflowId,
+	parent *workflowAPI.Execution,
+	stateValues map[string]any,
+) (*workflowAPI.Execution, error) {
+	workflow, err := engine.repository.GetWorkflowById(workflowId)
+	if err != nil {
+		return nil, fmt.Errorf("get workflow %s: %w", workflowId, err)
+	}
+
+	if len(workflow.Steps) == 0 {
+		return nil, fmt.Errorf("workflow %s: %w", workflowId, workflowAPI.ErrStepNotFound)
+	}
+
+	for key, value := range stateValues {
+		if err := workflow.ValidateState(key, value); err != nil {
+			return nil, fmt.Errorf("workflow %s: %w", workflowId, err)
+		}
+	}
+
+	firstStep := workflow.Steps[0]
+	execution := workflowAPI.Execution{
+		Id:              workflowAPI.ExecutionId(uuid.NewString()),
+		WorkflowId:      workflow.Metadata.ID,
+		WorkflowVersion: workflow.Metadata.Version,
+		Status:          workflowAPI.ExecutionStatusPending,
+		StateValues:     stateValues,
+		StepId:          firstStep.ID,
+	}
+	if firstStep.Approval != nil {
+		execution.Status = workflowAPI.ExecutionStatusAwaitingApproval
+	}
+
+	started := workflowAPI.Event{Type: workflowAPI.EventTypeStarted, WorkflowVersion: execution.WorkflowVersion}
+	if parent != nil {
+		execution.ParentExecutionId = parent.Id
+		started.RelatedExecutionId = parent.Id
+	}
+
+	if err := engine.repository.AddExecution(execution); err != nil {
+		return nil, fmt.Errorf("add execution: %w", err)
+	}
+
+	err = engine.record(execution.Id, actor,
+		started,
+		workflowAPI.Event{Type: workflowAPI.EventTypeStepEntered, StepId: execution.StepId},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if execution.Status == workflowAPI.ExecutionStatusAwaitingApproval || firstStep.Workflow == nil {
+		return &execution, nil
+	}
+
+	suspended, err := engine.invoke(actor, &execution, firstStep)
+	if err != nil {
+		// The execution cannot make any progress without its sub-workflow, so it is not left pending.
+		now := engine.clock.Now()
+		execution.Status = workflowAPI.ExecutionStatusAborted
+		execution.AbortedAt = &now
+		_, _ = engine.update(execution, actor, workflowAPI.Event{Type: workflowAPI.EventTypeAborted, StepId: execution.StepId})
+
+		return nil, err
+	}
+
+	return engine.update(execution, actor, suspended)
+}
+
+func (engine *Engine) GetExecution(executionId workflowAPI.ExecutionId) (*workflowAPI.Execution, error) {
+	execution, err := engine.repository.GetExecutionById(executionId)
+	if err != nil {
+		return nil, fmt.Errorf("get execution %s: %w", executionId, err)
+	}
+
+	return execution, nil
+}
+
+func (engine *Engine) SetState(actor workflowAPI.Actor, executionId workflowAPI.ExecutionId, key string, value any) (*workflowAPI.Execution, error) {
+	workflow, execution, err := engine.loadActive(executionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkWorkable(*execution); err != nil {
+		return nil, err
+	}
+
+	if err := workflow.ValidateState(key, value); err != nil {
+		return nil, err
+	}
+
+	if execution.StateValues == nil {
+		execution.StateValues = map[string]any{}
+	}
+	oldValue := execution.StateValues[key]
+	execution.StateValues[key] = value
+	execution.Status = workflowAPI.ExecutionStatusRunning
+
+	return engine.update(*execution, actor, workflowAPI.Event{
+		Type:     workflowAPI.EventTypeStateChanged,
+		StepId:   execution.StepId,
+		Key:      key,
+		OldValue: oldValue,
+		NewValue: value,
+	})
+}
+
+func (engine *Engine) Next(actor workflowAPI.Actor, executionId workflowAPI.ExecutionId) (*workflowAPI.Execution, error) {
+	workflow, execution, err := engine.loadActive(executionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkWorkable(*execution); err != nil {
+		return nil, err
+	}
+
+	return engine.advance(actor, workflow, execution)
+}
+
+func (engine *Engine) Abort(actor workflowAPI.Actor, executionId workflowAPI.ExecutionId) (*workflowAPI.Execution, error) {
+	_, execution, err := engine.loadActive(executionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return engine.abort(actor, execution)
+}
+
+func (engine *Engine) Approve(actor workflowAPI.Actor, executionId workflowAPI.ExecutionId) (*workflowAPI.Execution, error) {
+	workflow, execution, err := engine.loadAwaitingApproval(executionId)
+	if err != nil {
+		return nil, err
+	}
+
+	execution.Status = workflowAPI.ExecutionStatusRunning
+
+	events := []workflowAPI.Event{{Type: workflowAPI.EventTypeApproved, StepId: execution.StepId}}
+
+	if index, found := workflow.StepIndex(execution.StepId); found && workflow.Steps[index].Workflow != nil {
+		suspended, err := engine.invoke(actor, execution, workflow.Steps[index])
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, suspended)
+	}
+
+	return engine.update(*execution, actor, events...)
+}
+
+func (engine *Engine) Reject(actor workflowAPI.Actor, executionId workflowAPI.ExecutionId, reason string) (*workflowAPI.Execution, error) {
+	workflow, execution, err := engine.loadAwaitingApproval(executionId)
+	if err != nil {
+		return nil, err
+	}
+
+	execution.Rejection = &workflowAPI.Rejection{
+		StepId: execution.StepId,
+		Reason: reason,
+	}
+
+	rejected := workflowAPI.Event{Type: workflowAPI.EventTypeRejected, StepId: execution.StepId, Reason: reason}
+
+	if execution.PreviousStepId == "" {
+		return engine.abort(actor, execution, rejected)
+	}
+
+	execution.StepId = execution.PreviousStepId
+	execution.PreviousStepId = ""
+	execution.Status = workflowAPI.ExecutionStatusRunning
+
+	events := []workflowAPI.Event{rejected, {Type: workflowAPI.EventTypeStepEntered, StepId: execution.StepId}}
+
+	if index, found := workflow.StepIndex(execution.StepId); found && workflow.Steps[index].Workflow != nil {
+		suspended, err := engine.invoke(actor, execution, workflow.Steps[index])
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, suspended)
+	}
+
+	return engine.update(*execution, actor, events...)
+}
+
+func (engine *Engine) Migrate(actor workflowAPI.Actor, executionId workflowAPI.ExecutionId, stepIds map[string]string) (*workflowAPI.Execution, error) {
+	// The version the execution runs is not loaded, since it is not needed and may no longer be kept.
+	execution, err := engine.GetExecution(executionId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case execution.Status.IsFinished():
+		return nil, fmt.Errorf("execution %s is %s: %w", executionId, execution.Status, workflowAPI.ErrExecutionFinished)
+	case execution.Status == workflowAPI.ExecutionStatusSuspended:
+		return nil, fmt.Errorf("execution %s step %s waits for execution %s: %w", execution.Id, execution.StepId, execution.ChildExecutionId, workflowAPI.ErrExecutionSuspended)
+	}
+
+	workflow, err := engine.repository.GetWorkflowById(execution.WorkflowId)
+	if err != nil {
+		return nil, fmt.Errorf("get workflow %s: %w", execution.WorkflowId, err)
+	}
+
+	if workflow.Metadata.Version == execution.WorkflowVersion {
+		return nil, fmt.Errorf("execution %s workflow %s version %s: %w", execution.Id, execution.WorkflowId, execution.WorkflowVersion, workflowAPI.ErrExecutionUpToDate)
+	}
+
+	migrateStepId := func(stepId string) (string, bool) {
+		if mapped, found := stepIds[stepId]; found {
+			stepId = mapped
+		}
+		_, found := workflow.StepIndex(stepId)
+		return stepId, found
+	}
+
+	stepId, found := migrateStepId(execution.StepId)
+	if !found {
+		return nil, fmt.Errorf("execution %s step %s: %w", execution.Id, stepId, workflowAPI.ErrStepNotFound)
+	}
+	index, _ := workflow.StepIndex(stepId)
+	step := workflow.Steps[index]
+
+	events := []workflowAPI.Event{{Type: workflowAPI.EventTypeMigrated, StepId: stepId, WorkflowVersion: workflow.Metadata.Version}}
+
+	for _, key := range slices.Sorted(maps.Keys(execution.StateValues)) {
+		value := execution.StateValues[key]
+
+		err := workflow.ValidateState(key, value)
+		if errors.Is(err, workflowAPI.ErrStateNotDeclared) {
+			delete(execution.StateValues, key)
+			events = append(events, workflowAPI.Event{Type: workflowAPI.EventTypeStateChanged, StepId: stepId, Key: key, OldValue: value})
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("execution %s: %w", execution.Id, err)
+		}
+	}
+
+	// The previous step and the rejection only matter while their steps still exist.
+	if previousStepId, found := migrateStepId(execution.PreviousStepId); found {
+		execution.PreviousStepId = previousStepId
+	} else {
+		execution.PreviousStepId = ""
+	}
+
+	if execution.Rejection != nil {
+		if rejectedStepId, found := migrateStepId(execution.Rejection.StepId); found {
+			execution.Rejection.StepId = rejectedStepId
+		} else {
+			execution.Rejection = nil
+		}
+	}
+
+	execution.WorkflowVersion = workflow.Metadata.Version
+	execution.StepId = stepId
+
+	// A step that requires approval is entered anew, so that migrating does not skip the human sign-off.
+	switch {
+	case step.Approval != nil:
+		if execution.Status != workflowAPI.ExecutionStatusAwaitingApproval {
+			execution.Status = workflowAPI.ExecutionStatusAwaitingApproval
+			events = append(events, workflowAPI.Event{Type: workflowAPI.EventTypeStepEntered, StepId: stepId})
+		}
+	case step.Workflow != nil:
+		suspended, err := engine.invoke(actor, execution, step)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, suspended)
+	case execution.Status == workflowAPI.ExecutionStatusAwaitingApproval:
+		execution.Status = workflowAPI.ExecutionStatusRunning
+	}
+
+	return engine.update(*execution, actor, events...)
+}
+
+func (engine *Engine) History(executionId workflowAPI.ExecutionId) ([]workflowAPI.Event, error) {
+	events, err := engine.repository.GetEvents(executionId)
+	if err != nil {
+		return nil, fmt.Errorf("get execution %s events: %w", executionId, err)
+	}
+
+	return events, nil
+}
+
+func (engine *Engine) loadAwaitingApproval(executionId workflowAPI.ExecutionId) (*workflowAPI.Workflow, *workflowAPI.Execution, error) {
+	workflow, execution, err := engine.loadActive(executionId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if execution.Status != workflowAPI.ExecutionStatusAwaitingApproval {
+		return nil, nil, fmt.Errorf("execution %s is %s: %w", executionId, execution.Status, workflowAPI.ErrExecutionNotAwaitingApproval)
+	}
+
+	return workflow, execution, nil
+}
+
+// checkWorkable returns an error when the current step of the execution waits for a human or for a sub-workflow
+// execution.
+func checkWorkable(execution workflowAPI.Execution) error {
+	switch execution.Status {
+	case workflowAPI.ExecutionStatusAwaitingApproval:
+		return fmt.Errorf("execution %s step %s: %w", execution.Id, execution.StepId, workflowAPI.ErrExecutionAwaitingApproval)
+	case workflowAPI.ExecutionStatusSuspended:
+		return fmt.Errorf("execution %s step %s waits for execution %s: %w", execution.Id, execution.StepId, execution.ChildExecutionId, workflowAPI.ErrExecutionSuspended)
+	}
+
+	return nil
+}
+
+// advance completes the current step of the execution and moves it to the step chosen by the step transitions.
+// The given events are recorded before the step completion.
+func (engine *Engine) advance(
+	actor workflowAPI.Actor,
+	workflow *workflowAPI.Workflow,
+	execution *workflowAPI.Execution,
+	events ...workflowAPI.Event,
+) (*workflowAPI.Execution, error) {
+	index, found := workflow.StepIndex(execution.StepId)
+	if !found {
+		return nil, fmt.Errorf("execution %s step %s: %w", execution.Id, execution.StepId, workflowAPI.ErrStepNotFound)
+	}
+
+	if err := workflow.Steps[index].ValidateOutputs(execution.StateValues); err != nil {
+		return nil, err
+	}
+
+	next, completed, err := workflow.NextStep(execution.StepId, execution.StateValues)
+	if err != nil {
+		return nil, fmt.Errorf("execution %s: %w", execution.Id, err)
+	}
+
+	execution.Rejection = nil
+
+	events = append(events, workflowAPI.Event{Type: workflowAPI.EventTypeStepCompleted, StepId: execution.StepId})
+
+	if completed {
+		return engine.complete(actor, execution, events...)
+	}
+
+	execution.PreviousStepId = execution.StepId
+	execution.StepId = next
+	execution.Status = workflowAPI.ExecutionStatusRunning
+
+	events = append(events, workflowAPI.Event{Type: workflowAPI.EventTypeStepEntered, StepId: next})
+
+	nextIndex, found := workflow.StepIndex(next)
+	switch {
+	case !found:
+		return nil, fmt.Errorf("execution %s step %s: %w", execution.Id, next, workflowAPI.ErrStepNotFound)
+	case workflow.Steps[nextIndex].Approval != nil:
+		execution.Status = workflowAPI.ExecutionStatusAwaitingApproval
+	case workflow.Steps[nextIndex].Workflow != nil:
+		suspended, err := engine.invoke(actor, execution, workflow.Steps[nextIndex])
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, suspended)
+	}
+
+	return engine.update(*execution, actor, events...)
+}
+
+// complete completes the execution. A completed sub-workflow execution hands its outputs over to its parent
+// execution, which then advances past the step that invoked the sub-workflow.
+func (engine *Engine) complete(actor workflowAPI.Actor, execution *workflowAPI.Execution, events ...workflowAPI.Event) (*workflowAPI.Execution, error) {
+	now := engine.clock.Now()
+	execution.Status = workflowAPI.ExecutionStatusCompleted
+	execution.CompletedAt = &now
+	events = append(events, workflowAPI.Event{Type: workflowAPI.EventTypeCompleted})
+
+	if execution.ParentExecutionId == "" {
+		return engine.update(*execution, actor, events...)
+	}
+
+	// The parent is advanced before the execution is stored as completed, so that an execution is not completed
+	// when its outputs do not fit the parent or the parent cannot move on, which would leave the parent
+	// suspended for an execution that can no longer complete.
+	parentWorkflow, parent, resumed, err := engine.loadSuspendedParent(*execution)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := engine.advance(actor, parentWorkflow, parent, resumed...); err != nil {
+		return nil, fmt.Errorf("resume execution %s: %w", parent.Id, err)
+	}
+
+	return engine.update(*execution, actor, events...)
+}
+
+// loadSuspendedParent returns the parent of a completed sub-workflow execution with the outputs of the
+// sub-workflow copied into its state, together with the events describing the resumption.
+func (engine *Engine) loadSuspendedParent(child workflowAPI.Execution) (*workflowAPI.Workflow, *workflowAPI.Execution, []workflowAPI.Event, error) {
+	workflow, parent, err := engine.loadActive(child.ParentExecutionId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	index, found := workflow.StepIndex(parent.StepId)
+	if !found || workflow.Steps[index].Workflow == nil || parent.Status != workflowAPI.ExecutionStatusSuspended || parent.ChildExecutionId != child.Id {
+		return nil, nil, nil, fmt.Errorf("execution %s for %s: %w", parent.Id, child.Id, workflowAPI.ErrExecutionNotSuspended)
+	}
+
+	step := workflow.Steps[index]
+
+	if parent.StateValues == nil {
+		parent.StateValues = map[string]any{}
+	}
+
+	events := []workflowAPI.Event{{Type: workflowAPI.EventTypeResumed, StepId: parent.StepId, RelatedExecutionId: child.Id}}
+
+	for _, key := range slices.Sorted(maps.Keys(step.Workflow.Outputs)) {
+		value, set := child.StateValues[step.Workflow.Outputs[key]]
+		if !set {
+			continue
+		}
+
+		if err := workflow.ValidateState(key, value); err != nil {
+			return nil, nil, nil, fmt.Errorf("execution %s: %w", parent.Id, err)
+		}
+
+		events = append(events, workflowAPI.Event{
+			Type:     workflowAPI.EventTypeStateChanged,
+			StepId:   parent.StepId,
+			Key:      key,
+			OldValue: parent.StateValues[key],
+			NewValue: value,
+		})
+		parent.StateValues[key] = value
+	}
+
+	if err := step.ValidateOutputs(parent.StateValues); err != nil {
+		return nil, nil, nil, fmt.Errorf("execution %s: %w", parent.Id, err)
+	}
+
+	parent.ChildExecutionId = ""
+
+	return workflow, parent, events, nil
+}
+
+// invoke starts a child execution of the workflow the step invokes, with the inputs copied from the state of the
+// execution, and suspends the execution until the child completes. It returns the event describing the
+// suspension.
+func (engine *Engine) invoke(actor workflowAPI.Actor, execution *workflowAPI.Execution, step workflowAPI.Step) (workflowAPI.Event, error) {
+	if err := engine.checkInvocationCycle(*execution, step.Workflow.WorkflowId); err != nil {
+		return workflowAPI.Event{}, err
+	}
+
+	stateValues := map[string]any{}
+	for childKey, parentKey := range step.Workflow.Inputs {
+		if value, set := execution.StateValues[parentKey]; set {
+			stateValues[childKey] = value
+		}
+	}
+
+	child, err := engine.start(actor, step.Workflow.WorkflowId, execution, stateValues)
+	if err != nil {
+		return workflowAPI.Event{}, fmt.Errorf("execution %s step %s: %w", execution.Id, step.ID, err)
+	}
+
+	execution.Status = workflowAPI.ExecutionStatusSuspended
+	execution.ChildExecutionId = child.Id
+
+	return workflowAPI.Event{Type: workflowAPI.EventTypeSuspended, StepId: step.ID, RelatedExecutionId: child.Id}, nil
+}
+
+// checkInvocationCycle returns an error when the workflow is already run by the execution or one of the
+// executions that started it, since invoking it again would never end.
+func (engine *Engine) checkInvocationCycle(execution workflowAPI.Execution, workflowId workflowAPI.WorkflowId) error {
+	for current := &execution; ; {
+		if current.WorkflowId == workflowId {
+			return fmt.Errorf("execution %s workflow %s: %w", execution.Id, workflowId, workflowAPI.ErrWorkflowInvocationCycle)
+		}
+
+		if current.ParentExecutionId == "" {
+			return nil
+		}
+
+		parent, err := engine.GetExecution(current.ParentExecutionId)
+		if err != nil {
+			return err
+		}
+		current = parent
+	}
+}
+
+// abort aborts the execution together with the sub-workflow execution it waits for and the execution that
+// started it, so that no execution is left waiting for an aborted one.
+func (engine *Engine) abort(actor workflowAPI.Actor, execution *workflowAPI.Execution, events ...workflowAPI.Event) (*workflowAPI.Execution, error) {
+	now := engine.clock.Now()
+	execution.Status = workflowAPI.ExecutionStatusAborted
+	execution.AbortedAt = &now
+
+	aborted, err := engine.update(*execution, actor, append(events, workflowAPI.Event{Type: workflowAPI.EventTypeAborted, StepId: execution.StepId})...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, relatedId := range []workflowAPI.ExecutionId{execution.ChildExecutionId, execution.ParentExecutionId} {
+		if relatedId == "" {
+			continue
+		}
+
+		related, err := engine.GetExecution(relatedId)
+		if err != nil {
+			return nil, err
+		}
+		if related.Status.IsFinished() {
+			continue
+		}
+
+		if _, err := engine.abort(actor, related); err != nil {
+			return nil, err
+		}
+	}
+
+	return aborted, nil
+}
+
+// loadActive returns an execution that can still change together with its workflow.
+func (engine *Engine) loadActive(executionId workflowAPI.ExecutionId) (*workflowAPI.Workflow, *workflowAPI.Execution, error) {
+	execution, err := engine.GetExecution(executionId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if execution.Status.IsFinished() {
+		return nil, nil, fmt.Errorf("execution %s is %s: %w", executionId, execution.Status, workflowAPI.ErrExecutionFinished)
+	}
+
+	workflow, err := engine.repository.GetWorkflowVersion(execution.WorkflowId, execution.WorkflowVersion)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get workflow %s: %w", execution.WorkflowId, err)
+	}
+
+	return workflow, execution, nil
+}
+
+// update stores the execution together with the events describing the change, so that the history always
+// matches the stored execution.
+func (engine *Engine) update(execution workflowAPI.Execution, actor workflowAPI.Actor, events ...workflowAPI.Event) (*workflowAPI.Execution, error) {
+	if err := engine.repository.UpdateExecution(execution, engine.stamp(actor, events)); err != nil {
+		return nil, fmt.Errorf("update execution %s: %w", execution.Id, err)
+	}
+	execution.Revision++
+
+	return &execution, nil
+}
+
+func (engine *Engine) record(executionId workflowAPI.ExecutionId, actor workflowAPI.Actor, events ...workflowAPI.Event) error {
+	for _, event := range engine.stamp(actor, events) {
+		if err := engine.repository.AppendEvent(executionId, event); err != nil {
+			return fmt.Errorf("append execution %s event %s: %w", executionId, event.Type, err)
+		}
+	}
+
+	return nil
+}
+
+// stamp sets the time and actor of the events.
+func (engine *Engine) stamp(actor workflowAPI.Actor, events []workflowAPI.Event) []workflowAPI.Event {
+	now := engine.clock.Now()
+
+	stamped := make([]workflowAPI.Event, 0, len(events))
+	for _, event := range events {
+		event.Time = now
+		event.Actor = actor
+		stamped = append(stamped, event)
+	}
+
+	return stamped
+}
@@ -0,0 +1,965 @@
+package workflow
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/coder/quartz"
+	"github.com/invopop/jsonschema"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testActor = workflowAPI.Actor{Kind: workflowAPI.ActorKindAgent, Name: "claude-code"}
+
+func engineTestWorkflow() workflowAPI.Workflow {
+	return workflowAPI.Workflow{
+		Metadata: workflowAPI.Metadata{
+			ID:          "example",
+			Name:        "Example",
+			Description: "Example workflow.",
+			Version:     "0.1.0",
+		},
+		State: map[string]*jsonschema.Schema{
+			"firstNumber": {Type: "integer"},
+		},
+		Steps: []workflowAPI.Step{
+			{ID: "prepare", Name: "Prepare", Description: "Prepare numbers.", Instructions: []string{"Pick a number."}},
+			{ID: "add", Name: "Add", Description: "Add numbers.", Instructions: []string{"Add the numbers."}},
+		},
+	}
+}
+
+func validatedTestWorkflow() workflowAPI.Workflow {
+	workflow := engineTestWorkflow()
+	workflow.Metadata.ID = "validated"
+	workflow.State = map[string]*jsonschema.Schema{
+		"firstNumber": {Type: "integer", Minimum: json.Number("1"), Maximum: json.Number("100")},
+	}
+	workflow.Steps[0].Outputs = []string{"firstNumber"}
+
+	return workflow
+}
+
+func newTestEngine(t *testing.T) (*Engine, *FsRepository) {
+	t.Helper()
+
+	repository := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+	require.NoError(t, repository.AddWorkflow(engineTestWorkflow()))
+	require.NoError(t, repository.AddWorkflow(validatedTestWorkflow()))
+
+	return NewEngine(repository, quartz.NewMock(t)), repository
+}
+
+func TestEngine_Execute_WhenWorkflowExists_ThenCreatesPendingExecution(t *testing.T) {
+	t.Parallel()
+
+	engine, repository := newTestEngine(t)
+
+	executionId, err := engine.Execute(testActor, "example")
+
+	require.NoError(t, err)
+	require.NotEmpty(t, executionId)
+
+	execution, err := repository.GetExecutionById(executionId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.WorkflowId("example"), execution.WorkflowId)
+	assert.Equal(t, "0.1.0", execution.WorkflowVersion)
+	assert.Equal(t, workflowAPI.ExecutionStatusPending, execution.Status)
+	assert.Equal(t, "prepare", execution.StepId)
+	assert.Empty(t, execution.StateValues)
+}
+
+func TestEngine_Execute_WhenCalledTwice_ThenGeneratesDistinctIds(t *testing.T) {
+	t.Parallel()
+
+	engine, _ := newTestEngine(t)
+
+	first, err := engine.Execute(testActor, "example")
+	require.NoError(t, err)
+	second, err := engine.Execute(testActor, "example")
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first, second)
+}
+
+func TestEngine_Execute_WhenWorkflowNotFound_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	engine, _ := newTestEngine(t)
+
+	_, err := engine.Execute(testActor, "missing")
+
+	require.ErrorIs(t, err, workflowAPI.ErrWorkflowNotFound)
+}
+
+func TestEngine_Execute_WhenAddExecutionFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	workflow := engineTestWorkflow()
+	repository := workflowAPI.NewMockRepository(t)
+	repository.EXPECT().GetWorkflowById(workflowAPI.WorkflowId("example")).Return(&workflow, nil)
+	repository.EXPECT().AddExecution(mock.AnythingOfType("workflow.Execution")).Return(errors.New("disk failure"))
+
+	_, err := NewEngine(repository, quartz.NewMock(t)).Execute(testActor, "example")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "add execution: disk failure")
+}
+
+func TestEngine_SetState_WhenKeyDeclared_ThenStoresValueAndRuns(t *testing.T) {
+	t.Parallel()
+
+	engine, repository := newTestEngine(t)
+	executionId, err := engine.Execute(testActor, "example")
+	require.NoError(t, err)
+
+	execution, err := engine.SetState(testActor, executionId, "firstNumber", 42)
+
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusRunning, execution.Status)
+	assert.Equal(t, 42, execution.StateValues["firstNumber"])
+
+	stored, err := repository.GetExecutionById(executionId)
+	require.NoError(t, err)
+	assert.EqualValues(t, 42, stored.StateValues["firstNumber"])
+}
+
+func TestEngine_SetState_WhenStored_ThenReturnsStoredRevision(t *testing.T) {
+	t.Parallel()
+
+	engine, repository := newTestEngine(t)
+	executionId, err := engine.Execute(testActor, "example")
+	require.NoError(t, err)
+
+	_, err = engine.SetState(testActor, executionId, "firstNumber", 1)
+	require.NoError(t, err)
+	execution, err := engine.SetState(testActor, executionId, "firstNumber", 2)
+
+	require.NoError(t, err)
+
+	stored, err := repository.GetExecutionById(executionId)
+	require.NoError(t, err)
+	assert.Equal(t, 2, stored.Revision)
+	assert.Equal(t, stored.Revision, execution.Revision)
+}
+
+func TestEngine_SetState_WhenKeyNotDeclared_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	engine, _ := newTestEngine(t)
+	executionId, err := engine.Execute(testActor, "example")
+	require.NoError(t, err)
+
+	_, err = engine.SetState(testActor, executionId, "unknown", 1)
+
+	require.ErrorIs(t, err, workflowAPI.ErrStateNotDeclared)
+}
+
+func TestEngine_SetState_WhenValueBreaksSchema_ThenReturnsStateError(t *testing.T) {
+	t.Parallel()
+
+	engine, repository := newTestEngine(t)
+	executionId, err := engine.Execute(testActor, "validated")
+	require.NoError(t, err)
+
+	_, err = engine.SetState(testActor, executionId, "firstNumber", 150)
+
+	require.ErrorIs(t, err, workflowAPI.ErrStateInvalid)
+	var stateErr *workflowAPI.StateError
+	require.ErrorAs(t, err, &stateErr)
+	assert.Equal(t, "firstNumber", stateErr.Key)
+	require.Len(t, stateErr.Violations, 1)
+	assert.Equal(t, "/maximum", stateErr.Violations[0].Keyword)
+
+	stored, err := repository.GetExecutionById(executionId)
+	require.NoError(t, err)
+	assert.Empty(t, stored.StateValues)
+	assert.Equal(t, workflowAPI.ExecutionStatusPending, stored.Status)
+}
+
+func TestEngine_Next_WhenOutputsMissing_ThenRefusesToAdvance(t *testing.T) {
+	t.Parallel()
+
+	engine, repository := newTestEngine(t)
+	executionId, err := engine.Execute(testActor, "validated")
+	require.NoError(t, err)
+
+	_, err = engine.Next(testActor, executionId)
+
+	require.ErrorIs(t, err, workflowAPI.ErrStepOutputsMissing)
+	var outputsErr *workflowAPI.OutputsError
+	require.ErrorAs(t, err, &outputsErr)
+	assert.Equal(t, []string{"firstNumber"}, outputsErr.Missing)
+
+	stored, err := repository.GetExecutionById(executionId)
+	require.NoError(t, err)
+	assert.Equal(t, "prepare", stored.StepId)
+}
+
+func TestEngine_Next_WhenOutputsSet_ThenAdvances(t *testing.T) {
+	t.Parallel()
+
+	engine, _ := newTestEngine(t)
+	executionId, err := engine.Execute(testActor, "validated")
+	require.NoError(t, err)
+	_, err = engine.SetState(testActor, executionId, "firstNumber", 42)
+	require.NoError(t, err)
+
+	execution, err := engine.Next(testActor, executionId)
+
+	require.NoError(t, err)
+	assert.Equal(t, "add", execution.StepId)
+}
+
+func TestEngine_Next_WhenTransitionHolds_ThenMovesToTransitionTarget(t *testing.T) {
+	t.Parallel()
+
+	engine, repository := newTestEngine(t)
+	workflow := engineTestWorkflow()
+	workflow.Metadata.ID = "branching"
+	workflow.Steps = append(workflow.Steps, workflowAPI.Step{ID: "release", Name: "Release", Description: "Release.", Instructions: []string{"Release."}})
+	workflow.Steps[1].Transitions = []workflowAPI.Transition{{When: "firstNumber > 10", Next: "prepare"}}
+	require.NoError(t, repository.AddWorkflow(workflow))
+
+	executionId, err := engine.Execute(testActor, "branching")
+	require.NoError(t, err)
+	_, err = engine.Next(testActor, executionId)
+	require.NoError(t, err)
+	_, err = engine.SetState(testActor, executionId, "firstNumber", 42)
+	require.NoError(t, err)
+
+	execution, err := engine.Next(testActor, executionId)
+	require.NoError(t, err)
+	assert.Equal(t, "prepare", execution.StepId)
+
+	_, err = engine.Next(testActor, executionId)
+	require.NoError(t, err)
+	_, err = engine.SetState(testActor, executionId, "firstNumber", 5)
+	require.NoError(t, err)
+
+	execution, err = engine.Next(testActor, executionId)
+	require.NoError(t, err)
+	assert.Equal(t, "release", execution.StepId)
+	assert.Equal(t, workflowAPI.ExecutionStatusRunning, execution.Status)
+}
+
+func TestEngine_Next_WhenStepsRemain_ThenAdvancesToNextStep(t *testing.T) {
+	t.Parallel()
+
+	engine, _ := newTestEngine(t)
+	executionId, err := engine.Execute(testActor, "example")
+	require.NoError(t, err)
+
+	execution, err := engine.Next(testActor, executionId)
+
+	require.NoError(t, err)
+	assert.Equal(t, "add", execution.StepId)
+	assert.Equal(t, workflowAPI.ExecutionStatusRunning, execution.Status)
+}
+
+func TestEngine_Next_WhenOnLastStep_ThenCompletesExecution(t *testing.T) {
+	t.Parallel()
+
+	engine, _ := newTestEngine(t)
+	executionId, err := engine.Execute(testActor, "example")
+	require.NoError(t, err)
+	_, err = engine.Next(testActor, executionId)
+	require.NoError(t, err)
+
+	execution, err := engine.Next(testActor, executionId)
+
+	require.NoError(t, err)
+	assert.Equal(t, "add", execution.StepId)
+	assert.Equal(t, workflowAPI.ExecutionStatusCompleted, execution.Status)
+	assert.NotNil(t, execution.CompletedAt)
+	assert.Nil(t, execution.AbortedAt)
+}
+
+func TestEngine_Next_WhenStepMissingFromWorkflow_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	engine, repository := newTestEngine(t)
+	require.NoError(t, repository.AddExecution(workflowAPI.Execution{
+		Id:          "orphan",
+		WorkflowId:  "example",
+		Status:      workflowAPI.ExecutionStatusRunning,
+		StateValues: map[string]any{},
+		StepId:      "removed",
+	}))
+
+	_, err := engine.Next(testActor, "orphan")
+
+	require.ErrorIs(t, err, workflowAPI.ErrStepNotFound)
+}
+
+func TestEngine_WhenExecutionFinished_ThenRejectsChanges(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		finish func(t *testing.T, engine *Engine, executionId workflowAPI.ExecutionId)
+	}{
+		{
+			name: "completed",
+			finish: func(t *testing.T, engine *Engine, executionId workflowAPI.ExecutionId) {
+				for range 2 {
+					_, err := engine.Next(testActor, executionId)
+					require.NoError(t, err)
+				}
+			},
+		},
+		{
+			name: "aborted",
+			finish: func(t *testing.T, engine *Engine, executionId workflowAPI.ExecutionId) {
+				execution, err := engine.Abort(testActor, executionId)
+				require.NoError(t, err)
+				assert.Equal(t, workflowAPI.ExecutionStatusAborted, execution.Status)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			engine, _ := newTestEngine(t)
+			executionId, err := engine.Execute(testActor, "example")
+			require.NoError(t, err)
+			tt.finish(t, engine, executionId)
+
+			_, err = engine.Next(testActor, executionId)
+			require.ErrorIs(t, err, workflowAPI.ErrExecutionFinished)
+
+			_, err = engine.SetState(testActor, executionId, "firstNumber", 1)
+			require.ErrorIs(t, err, workflowAPI.ErrExecutionFinished)
+
+			_, err = engine.Abort(testActor, executionId)
+			require.ErrorIs(t, err, workflowAPI.ErrExecutionFinished)
+		})
+	}
+}
+
+func TestEngine_GetExecution_WhenNotFound_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	engine, _ := newTestEngine(t)
+
+	_, err := engine.GetExecution("missing")
+
+	require.ErrorIs(t, err, workflowAPI.ErrExecutionNotFound)
+}
+
+func gatedTestWorkflow(gatedStep int) workflowAPI.Workflow {
+	workflow := engineTestWorkflow()
+	workflow.Metadata.ID = "gated"
+	workflow.Steps[gatedStep].Approval = &workflowAPI.Approval{Message: "Check the numbers."}
+
+	return workflow
+}
+
+func newGatedTestEngine(t *testing.T, gatedStep int) *Engine {
+	t.Helper()
+
+	repository := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+	require.NoError(t, repository.AddWorkflow(gatedTestWorkflow(gatedStep)))
+
+	return NewEngine(repository, quartz.NewMock(t))
+}
+
+func TestEngine_Execute_WhenFirstStepRequiresApproval_ThenAwaitsApproval(t *testing.T) {
+	t.Parallel()
+
+	engine := newGatedTestEngine(t, 0)
+
+	executionId, err := engine.Execute(testActor, "gated")
+	require.NoError(t, err)
+
+	execution, err := engine.GetExecution(executionId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusAwaitingApproval, execution.Status)
+	assert.Equal(t, "prepare", execution.StepId)
+}
+
+func TestEngine_Next_WhenEnteringGatedStep_ThenPausesUntilApproved(t *testing.T) {
+	t.Parallel()
+
+	engine := newGatedTestEngine(t, 1)
+	executionId, err := engine.Execute(testActor, "gated")
+	require.NoError(t, err)
+
+	execution, err := engine.Next(testActor, executionId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusAwaitingApproval, execution.Status)
+	assert.Equal(t, "add", execution.StepId)
+
+	_, err = engine.Next(testActor, executionId)
+	require.ErrorIs(t, err, workflowAPI.ErrExecutionAwaitingApproval)
+	_, err = engine.SetState(testActor, executionId, "firstNumber", 1)
+	require.ErrorIs(t, err, workflowAPI.ErrExecutionAwaitingApproval)
+
+	execution, err = engine.Approve(testActor, executionId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusRunning, execution.Status)
+	assert.Equal(t, "add", execution.StepId)
+
+	execution, err = engine.Next(testActor, executionId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusCompleted, execution.Status)
+}
+
+func TestEngine_Reject_WhenPreviousStepExists_ThenReturnsToItWithReason(t *testing.T) {
+	t.Parallel()
+
+	engine := newGatedTestEngine(t, 1)
+	executionId, err := engine.Execute(testActor, "gated")
+	require.NoError(t, err)
+	_, err = engine.Next(testActor, executionId)
+	require.NoError(t, err)
+
+	execution, err := engine.Reject(testActor, executionId, "Numbers are too small.")
+
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusRunning, execution.Status)
+	assert.Equal(t, "prepare", execution.StepId)
+	require.NotNil(t, execution.Rejection)
+	assert.Equal(t, "add", execution.Rejection.StepId)
+	assert.Equal(t, "Numbers are too small.", execution.Rejection.Reason)
+
+	execution, err = engine.Next(testActor, executionId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusAwaitingApproval, execution.Status)
+	assert.Nil(t, execution.Rejection)
+}
+
+func TestEngine_Reject_WhenFirstStepGated_ThenAbortsExecution(t *testing.T) {
+	t.Parallel()
+
+	engine := newGatedTestEngine(t, 0)
+	executionId, err := engine.Execute(testActor, "gated")
+	require.NoError(t, err)
+
+	execution, err := engine.Reject(testActor, executionId, "Not now.")
+
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusAborted, execution.Status)
+	require.NotNil(t, execution.Rejection)
+	assert.Equal(t, "Not now.", execution.Rejection.Reason)
+}
+
+func TestEngine_Approve_WhenNotAwaitingApproval_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	engine, _ := newTestEngine(t)
+	executionId, err := engine.Execute(testActor, "example")
+	require.NoError(t, err)
+
+	_, err = engine.Approve(testActor, executionId)
+	require.ErrorIs(t, err, workflowAPI.ErrExecutionNotAwaitingApproval)
+
+	_, err = engine.Reject(testActor, executionId, "No.")
+	require.ErrorIs(t, err, workflowAPI.ErrExecutionNotAwaitingApproval)
+}
+
+func TestEngine_Abort_WhenAwaitingApproval_ThenAbortsExecution(t *testing.T) {
+	t.Parallel()
+
+	engine := newGatedTestEngine(t, 0)
+	executionId, err := engine.Execute(testActor, "gated")
+	require.NoError(t, err)
+
+	execution, err := engine.Abort(testActor, executionId)
+
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusAborted, execution.Status)
+	assert.NotNil(t, execution.AbortedAt)
+	assert.Nil(t, execution.CompletedAt)
+}
+
+func TestEngine_History_WhenExecutionRuns_ThenRecordsEveryChange(t *testing.T) {
+	t.Parallel()
+
+	repository := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+	require.NoError(t, repository.AddWorkflow(engineTestWorkflow()))
+	clock := quartz.NewMock(t)
+	engine := NewEngine(repository, clock)
+	human := workflowAPI.Actor{Kind: workflowAPI.ActorKindHuman, Name: "jane"}
+	startedAt := clock.Now()
+
+	executionId, err := engine.Execute(testActor, "example")
+	require.NoError(t, err)
+	clock.Advance(time.Minute)
+	_, err = engine.SetState(testActor, executionId, "firstNumber", 1)
+	require.NoError(t, err)
+	_, err = engine.SetState(testActor, executionId, "firstNumber", 2)
+	require.NoError(t, err)
+	clock.Advance(time.Minute)
+	_, err = engine.Next(testActor, executionId)
+	require.NoError(t, err)
+	_, err = engine.Abort(human, executionId)
+	require.NoError(t, err)
+
+	events, err := engine.History(executionId)
+
+	require.NoError(t, err)
+	assert.Equal(t, []workflowAPI.Event{
+		{Time: startedAt, Type: workflowAPI.EventTypeStarted, Actor: testActor, WorkflowVersion: "0.1.0"},
+		{Time: startedAt, Type: workflowAPI.EventTypeStepEntered, Actor: testActor, StepId: "prepare"},
+		{Time: startedAt.Add(time.Minute), Type: workflowAPI.EventTypeStateChanged, Actor: testActor, StepId: "prepare", Key: "firstNumber", NewValue: float64(1)},
+		{Time: startedAt.Add(time.Minute), Type: workflowAPI.EventTypeStateChanged, Actor: testActor, StepId: "prepare", Key: "firstNumber", OldValue: float64(1), NewValue: float64(2)},
+		{Time: startedAt.Add(2 * time.Minute), Type: workflowAPI.EventTypeStepCompleted, Actor: testActor, StepId: "prepare"},
+		{Time: startedAt.Add(2 * time.Minute), Type: workflowAPI.EventTypeStepEntered, Actor: testActor, StepId: "add"},
+		{Time: startedAt.Add(2 * time.Minute), Type: workflowAPI.EventTypeAborted, Actor: human, StepId: "add"},
+	}, events)
+}
+
+func TestEngine_History_WhenGatedStepRejected_ThenRecordsReasonAndReturn(t *testing.T) {
+	t.Parallel()
+
+	engine := newGatedTestEngine(t, 1)
+	human := workflowAPI.Actor{Kind: workflowAPI.ActorKindHuman, Name: "jane"}
+
+	executionId, err := engine.Execute(testActor, "gated")
+	require.NoError(t, err)
+	_, err = engine.Next(testActor, executionId)
+	require.NoError(t, err)
+	_, err = engine.Reject(human, executionId, "Wrong numbers.")
+	require.NoError(t, err)
+
+	events, err := engine.History(executionId)
+
+	require.NoError(t, err)
+	require.Len(t, events, 6)
+	assert.Equal(t, workflowAPI.EventTypeRejected, events[4].Type)
+	assert.Equal(t, human, events[4].Actor)
+	assert.Equal(t, "add", events[4].StepId)
+	assert.Equal(t, "Wrong numbers.", events[4].Reason)
+	assert.Equal(t, workflowAPI.EventTypeStepEntered, events[5].Type)
+	assert.Equal(t, "prepare", events[5].StepId)
+}
+
+func TestEngine_History_WhenExecutionNotFound_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	engine, _ := newTestEngine(t)
+
+	_, err := engine.History("missing")
+
+	require.ErrorIs(t, err, workflowAPI.ErrExecutionNotFound)
+}
+
+// newSubWorkflowTestEngine returns an engine over a release workflow whose second step invokes a changelog
+// workflow.
+func newSubWorkflowTestEngine(t *testing.T) (*Engine, *FsRepository) {
+	t.Helper()
+
+	release := workflowAPI.Workflow{
+		Metadata: workflowAPI.Metadata{ID: "release", Name: "Release", Description: "Release.", Version: "1.0.0"},
+		State: map[string]*jsonschema.Schema{
+			"version":   {Type: "string"},
+			"changelog": {Type: "string", MaxLength: ptr(uint64(20))},
+		},
+		Steps: []workflowAPI.Step{
+			{ID: "prepare", Name: "Prepare", Description: "Pick a version.", Instructions: []string{"Pick a version."}},
+			{
+				ID:          "changelog",
+				Name:        "Changelog",
+				Description: "Write the changelog.",
+				Outputs:     []string{"changelog"},
+				Workflow: &workflowAPI.Invocation{
+					WorkflowId: "changelog",
+					Inputs:     map[string]string{"version": "version"},
+					Outputs:    map[string]string{"changelog": "entry"},
+				},
+			},
+			{ID: "publish", Name: "Publish", Description: "Publish.", Instructions: []string{"Publish."}},
+		},
+	}
+
+	changelog := workflowAPI.Workflow{
+		Metadata: workflowAPI.Metadata{ID: "changelog", Name: "Changelog", Description: "Changelog.", Version: "1.0.0"},
+		State: map[string]*jsonschema.Schema{
+			"version": {Type: "string"},
+			"entry":   {Type: "string"},
+		},
+		Steps: []workflowAPI.Step{
+			{ID: "write", Name: "Write", Description: "Write the entry.", Instructions: []string{"Write."}, Outputs: []string{"entry"}},
+		},
+	}
+
+	repository := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+	require.NoError(t, repository.AddWorkflow(release))
+	require.NoError(t, repository.AddWorkflow(changelog))
+
+	return NewEngine(repository, quartz.NewMock(t)), repository
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+// startChangelog runs the release workflow up to its sub-workflow step and returns the parent and child
+// execution IDs.
+func startChangelog(t *testing.T, engine *Engine) (workflowAPI.ExecutionId, workflowAPI.ExecutionId) {
+	t.Helper()
+
+	parentId, err := engine.Execute(testActor, "release")
+	require.NoError(t, err)
+	_, err = engine.SetState(testActor, parentId, "version", "1.2.0")
+	require.NoError(t, err)
+
+	parent, err := engine.Next(testActor, parentId)
+	require.NoError(t, err)
+
+	return parentId, parent.ChildExecutionId
+}
+
+func TestEngine_Next_WhenEnteringSubWorkflowStep_ThenStartsChildAndSuspends(t *testing.T) {
+	t.Parallel()
+
+	engine, _ := newSubWorkflowTestEngine(t)
+
+	parentId, childId := startChangelog(t, engine)
+
+	parent, err := engine.GetExecution(parentId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusSuspended, parent.Status)
+	assert.Equal(t, "changelog", parent.StepId)
+	require.NotEmpty(t, childId)
+
+	child, err := engine.GetExecution(childId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.WorkflowId("changelog"), child.WorkflowId)
+	assert.Equal(t, parentId, child.ParentExecutionId)
+	assert.Equal(t, "write", child.StepId)
+	assert.Equal(t, "1.2.0", child.StateValues["version"])
+}
+
+func TestEngine_WhenExecutionSuspended_ThenRejectsChanges(t *testing.T) {
+	t.Parallel()
+
+	engine, _ := newSubWorkflowTestEngine(t)
+	parentId, _ := startChangelog(t, engine)
+
+	_, err := engine.SetState(testActor, parentId, "version", "1.3.0")
+	require.ErrorIs(t, err, workflowAPI.ErrExecutionSuspended)
+
+	_, err = engine.Next(testActor, parentId)
+	require.ErrorIs(t, err, workflowAPI.ErrExecutionSuspended)
+}
+
+func TestEngine_Next_WhenChildCompletes_ThenResumesParentWithOutputs(t *testing.T) {
+	t.Parallel()
+
+	engine, _ := newSubWorkflowTestEngine(t)
+	parentId, childId := startChangelog(t, engine)
+	_, err := engine.SetState(testActor, childId, "entry", "Added sub-workflows.")
+	require.NoError(t, err)
+
+	child, err := engine.Next(testActor, childId)
+
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusCompleted, child.Status)
+
+	parent, err := engine.GetExecution(parentId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusRunning, parent.Status)
+	assert.Equal(t, "publish", parent.StepId)
+	assert.Empty(t, parent.ChildExecutionId)
+	assert.Equal(t, "Added sub-workflows.", parent.StateValues["changelog"])
+
+	events, err := engine.History(parentId)
+	require.NoError(t, err)
+	var types []workflowAPI.EventType
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	assert.Equal(t, []workflowAPI.EventType{
+		workflowAPI.EventTypeStarted,
+		workflowAPI.EventTypeStepEntered,
+		workflowAPI.EventTypeStateChanged,
+		workflowAPI.EventTypeStepCompleted,
+		workflowAPI.EventTypeStepEntered,
+		workflowAPI.EventTypeSuspended,
+		workflowAPI.EventTypeResumed,
+		workflowAPI.EventTypeStateChanged,
+		workflowAPI.EventTypeStepCompleted,
+		workflowAPI.EventTypeStepEntered,
+	}, types)
+	assert.Equal(t, childId, events[5].RelatedExecutionId)
+	assert.Equal(t, childId, events[6].RelatedExecutionId)
+}
+
+func TestEngine_Next_WhenChildOutputBreaksParentSchema_ThenKeepsBothExecutions(t *testing.T) {
+	t.Parallel()
+
+	engine, _ := newSubWorkflowTestEngine(t)
+	parentId, childId := startChangelog(t, engine)
+	_, err := engine.SetState(testActor, childId, "entry", "A changelog entry that is far too long.")
+	require.NoError(t, err)
+
+	_, err = engine.Next(testActor, childId)
+
+	var stateErr *workflowAPI.StateError
+	require.ErrorAs(t, err, &stateErr)
+	assert.Equal(t, "changelog", stateErr.Key)
+
+	child, err := engine.GetExecution(childId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusRunning, child.Status)
+
+	parent, err := engine.GetExecution(parentId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusSuspended, parent.Status)
+}
+
+func TestEngine_Next_WhenParentCannotAdvance_ThenKeepsChildRunning(t *testing.T) {
+	t.Parallel()
+
+	engine, repository := newSubWorkflowTestEngine(t)
+	release, err := repository.GetWorkflowById("release")
+	require.NoError(t, err)
+	changelog, err := repository.GetWorkflowById("changelog")
+	require.NoError(t, err)
+	release.Steps[1].Transitions = []workflowAPI.Transition{{When: "changelog > 3", Next: "publish"}}
+	require.NoError(t, repository.RemoveAllWorkflows())
+	require.NoError(t, repository.AddWorkflow(*release))
+	require.NoError(t, repository.AddWorkflow(*changelog))
+
+	parentId, childId := startChangelog(t, engine)
+	_, err = engine.SetState(testActor, childId, "entry", "Added sub-workflows.")
+	require.NoError(t, err)
+
+	_, err = engine.Next(testActor, childId)
+
+	require.ErrorIs(t, err, workflowAPI.ErrConditionTypeMismatch)
+
+	child, err := engine.GetExecution(childId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusRunning, child.Status)
+	assert.Nil(t, child.CompletedAt)
+
+	parent, err := engine.GetExecution(parentId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusSuspended, parent.Status)
+	assert.Equal(t, childId, parent.ChildExecutionId)
+
+	_, err = engine.Abort(testActor, childId)
+	require.NoError(t, err)
+
+	parent, err = engine.GetExecution(parentId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusAborted, parent.Status)
+}
+
+func TestEngine_Abort_WhenSubWorkflowRuns_ThenAbortsParentAndChild(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		aborted func(parentId workflowAPI.ExecutionId, childId workflowAPI.ExecutionId) workflowAPI.ExecutionId
+	}{
+		{name: "abort parent", aborted: func(parentId, _ workflowAPI.ExecutionId) workflowAPI.ExecutionId { return parentId }},
+		{name: "abort child", aborted: func(_, childId workflowAPI.ExecutionId) workflowAPI.ExecutionId { return childId }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			engine, _ := newSubWorkflowTestEngine(t)
+			parentId, childId := startChangelog(t, engine)
+
+			_, err := engine.Abort(testActor, tt.aborted(parentId, childId))
+
+			require.NoError(t, err)
+			for _, executionId := range []workflowAPI.ExecutionId{parentId, childId} {
+				execution, err := engine.GetExecution(executionId)
+				require.NoError(t, err)
+				assert.Equal(t, workflowAPI.ExecutionStatusAborted, execution.Status)
+			}
+		})
+	}
+}
+
+func TestEngine_Execute_WhenFirstStepInvokesWorkflow_ThenStartsSuspended(t *testing.T) {
+	t.Parallel()
+
+	engine, repository := newSubWorkflowTestEngine(t)
+	wrapper := workflowAPI.Workflow{
+		Metadata: workflowAPI.Metadata{ID: "wrapper", Name: "Wrapper", Description: "Wrapper.", Version: "1.0.0"},
+		Steps: []workflowAPI.Step{
+			{ID: "changelog", Name: "Changelog", Description: "Changelog.", Workflow: &workflowAPI.Invocation{WorkflowId: "changelog"}},
+		},
+	}
+	require.NoError(t, repository.AddWorkflow(wrapper))
+
+	executionId, err := engine.Execute(testActor, "wrapper")
+
+	require.NoError(t, err)
+	execution, err := engine.GetExecution(executionId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusSuspended, execution.Status)
+	assert.NotEmpty(t, execution.ChildExecutionId)
+}
+
+func TestEngine_Execute_WhenWorkflowsInvokeEachOther_ThenReturnsCycleError(t *testing.T) {
+	t.Parallel()
+
+	repository := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+	for _, ids := range [][2]workflowAPI.WorkflowId{{"ping", "pong"}, {"pong", "ping"}} {
+		require.NoError(t, repository.AddWorkflow(workflowAPI.Workflow{
+			Metadata: workflowAPI.Metadata{ID: ids[0], Name: string(ids[0]), Description: "Cycle.", Version: "1.0.0"},
+			Steps: []workflowAPI.Step{
+				{ID: "invoke", Name: "Invoke", Description: "Invoke.", Workflow: &workflowAPI.Invocation{WorkflowId: ids[1]}},
+			},
+		}))
+	}
+	engine := NewEngine(repository, quartz.NewMock(t))
+
+	_, err := engine.Execute(testActor, "ping")
+
+	require.ErrorIs(t, err, workflowAPI.ErrWorkflowInvocationCycle)
+
+	executions, err := repository.GetAllExecutions()
+	require.NoError(t, err)
+	require.Len(t, executions, 2)
+	for _, execution := range executions {
+		assert.Equal(t, workflowAPI.ExecutionStatusAborted, execution.Status)
+	}
+}
+
+// replaceWithNewVersion replaces the example workflow by version 0.2.0, which renames the prepare step to collect,
+// adds a review step and replaces the firstNumber state variable by numbers.
+func replaceWithNewVersion(t *testing.T, repository *FsRepository) {
+	t.Helper()
+
+	workflow := engineTestWorkflow()
+	workflow.Metadata.Version = "0.2.0"
+	workflow.State = map[string]*jsonschema.Schema{
+		"numbers": {Type: "array"},
+	}
+	workflow.Steps = []workflowAPI.Step{
+		{ID: "collect", Name: "Collect", Description: "Collect numbers.", Instructions: []string{"Collect numbers."}},
+		{ID: "add", Name: "Add", Description: "Add numbers.", Instructions: []string{"Add the numbers."}},
+		{ID: "review", Name: "Review", Description: "Review the sum.", Instructions: []string{"Review the sum."}},
+	}
+
+	require.NoError(t, repository.RemoveAllWorkflows())
+	require.NoError(t, repository.AddWorkflow(workflow))
+}
+
+func TestEngine_Next_WhenWorkflowReplacedByNewVersion_ThenKeepsRunningStartedVersion(t *testing.T) {
+	t.Parallel()
+
+	engine, repository := newTestEngine(t)
+	executionId, err := engine.Execute(testActor, "example")
+	require.NoError(t, err)
+	replaceWithNewVersion(t, repository)
+
+	execution, err := engine.Next(testActor, executionId)
+	require.NoError(t, err)
+	assert.Equal(t, "add", execution.StepId)
+
+	execution, err = engine.Next(testActor, executionId)
+
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusCompleted, execution.Status)
+	assert.Equal(t, "0.1.0", execution.WorkflowVersion)
+}
+
+func TestEngine_Migrate_WhenStepMapped_ThenMovesExecutionToCurrentVersion(t *testing.T) {
+	t.Parallel()
+
+	engine, repository := newTestEngine(t)
+	executionId, err := engine.Execute(testActor, "example")
+	require.NoError(t, err)
+	_, err = engine.SetState(testActor, executionId, "firstNumber", 3)
+	require.NoError(t, err)
+	replaceWithNewVersion(t, repository)
+
+	execution, err := engine.Migrate(testActor, executionId, map[string]string{"prepare": "collect"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "0.2.0", execution.WorkflowVersion)
+	assert.Equal(t, "collect", execution.StepId)
+	assert.Empty(t, execution.StateValues)
+
+	events, err := engine.History(executionId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.EventTypeMigrated, events[len(events)-2].Type)
+	assert.Equal(t, "0.2.0", events[len(events)-2].WorkflowVersion)
+	assert.Equal(t, "firstNumber", events[len(events)-1].Key)
+	assert.Nil(t, events[len(events)-1].NewValue)
+
+	execution, err = engine.Next(testActor, executionId)
+	require.NoError(t, err)
+	execution, err = engine.Next(testActor, executionId)
+	require.NoError(t, err)
+	assert.Equal(t, "review", execution.StepId)
+}
+
+func TestEngine_Migrate_WhenMappedStepRequiresApproval_ThenAwaitsApproval(t *testing.T) {
+	t.Parallel()
+
+	engine, repository := newTestEngine(t)
+	executionId, err := engine.Execute(testActor, "example")
+	require.NoError(t, err)
+	_, err = engine.Next(testActor, executionId)
+	require.NoError(t, err)
+
+	workflow := engineTestWorkflow()
+	workflow.Metadata.Version = "0.2.0"
+	workflow.Steps[1].Approval = &workflowAPI.Approval{Message: "Check the numbers."}
+	require.NoError(t, repository.RemoveAllWorkflows())
+	require.NoError(t, repository.AddWorkflow(workflow))
+
+	execution, err := engine.Migrate(testActor, executionId, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "add", execution.StepId)
+	assert.Equal(t, workflowAPI.ExecutionStatusAwaitingApproval, execution.Status)
+
+	events, err := engine.History(executionId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.EventTypeMigrated, events[len(events)-2].Type)
+	assert.Equal(t, workflowAPI.EventTypeStepEntered, events[len(events)-1].Type)
+	assert.Equal(t, "add", events[len(events)-1].StepId)
+
+	_, err = engine.Next(testActor, executionId)
+	require.ErrorIs(t, err, workflowAPI.ErrExecutionAwaitingApproval)
+
+	execution, err = engine.Approve(testActor, executionId)
+	require.NoError(t, err)
+	assert.Equal(t, workflowAPI.ExecutionStatusRunning, execution.Status)
+}
+
+func TestEngine_Migrate_WhenStepRemovedAndNotMapped_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	engine, repository := newTestEngine(t)
+	executionId, err := engine.Execute(testActor, "example")
+	require.NoError(t, err)
+	replaceWithNewVersion(t, repository)
+
+	_, err = engine.Migrate(testActor, executionId, nil)
+
+	require.ErrorIs(t, err, workflowAPI.ErrStepNotFound)
+}
+
+func TestEngine_Migrate_WhenAlreadyOnCurrentVersion_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	engine, _ := newTestEngine(t)
+	executionId, err := engine.Execute(testActor, "example")
+	require.NoError(t, err)
+
+	_, err = engine.Migrate(testActor, executionId, nil)
+
+	require.ErrorIs(t, err, workflowAPI.ErrExecutionUpToDate)
+}
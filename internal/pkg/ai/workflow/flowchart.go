@@ -0,0 +1,103 @@
+package workflow
+
+import (
+	"fmt"
+	"strings"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+// stepTransition is a way out of a completed step: either into another step or out of the workflow.
+type stepTransition struct {
+	// When is the condition of the transition, empty when it always holds.
+	When string
+
+	// End marks the transition that completes the execution.
+	End bool
+
+	// Index and Step describe the step the execution moves to, unless End is set.
+	Index int
+	Step  workflowAPI.Step
+}
+
+// stepTransitions lists the transitions out of the step at the given index in the order the engine evaluates
+// them, including the implicit move to the following step or to the end of the workflow.
+func stepTransitions(workflow workflowAPI.Workflow, index int) []stepTransition {
+	step := workflow.Steps[index]
+
+	var transitions []stepTransition
+	for _, transition := range step.Transitions {
+		if next, found := workflow.StepIndex(transition.Next); found {
+			transitions = append(transitions, stepTransition{
+				When:  transition.When,
+				Index: next,
+				Step:  workflow.Steps[next],
+			})
+		}
+
+		if transition.When == "" {
+			return transitions
+		}
+	}
+
+	if step.Terminal || index == len(workflow.Steps)-1 {
+		return append(transitions, stepTransition{End: true})
+	}
+
+	return append(transitions, stepTransition{Index: index + 1, Step: workflow.Steps[index+1]})
+}
+
+// flowchart returns a Mermaid flowchart of the workflow steps and the transitions between them. Steps that
+// require approval are drawn as hexagons and steps that invoke another workflow as subroutines.
+func flowchart(workflow workflowAPI.Workflow) string {
+	var builder strings.Builder
+
+	builder.WriteString("flowchart TD\n")
+	builder.WriteString("    start([Start])\n")
+
+	for index, step := range workflow.Steps {
+		label := mermaidLabel(fmt.Sprintf("%d. %s", index+1, step.Name))
+		switch {
+		case step.Approval != nil:
+			_, _ = fmt.Fprintf(&builder, "    %s{{%s}}\n", flowchartNode(index), label)
+		case step.Workflow != nil:
+			_, _ = fmt.Fprintf(&builder, "    %s[[%s]]\n", flowchartNode(index), label)
+		default:
+			_, _ = fmt.Fprintf(&builder, "    %s[%s]\n", flowchartNode(index), label)
+		}
+	}
+
+	builder.WriteString("    finish([End])\n")
+
+	if len(workflow.Steps) > 0 {
+		_, _ = fmt.Fprintf(&builder, "    start --> %s\n", flowchartNode(0))
+	}
+
+	for index := range workflow.Steps {
+		for _, transition := range stepTransitions(workflow, index) {
+			target := "finish"
+			if !transition.End {
+				target = flowchartNode(transition.Index)
+			}
+
+			if transition.When == "" {
+				_, _ = fmt.Fprintf(&builder, "    %s --> %s\n", flowchartNode(index), target)
+				continue
+			}
+
+			_, _ = fmt.Fprintf(&builder, "    %s -->|%s| %s\n", flowchartNode(index), mermaidLabel(transition.When), target)
+		}
+	}
+
+	return builder.String()
+}
+
+// flowchartNode returns the node ID of the step at the given index. Step IDs are not used directly, since they
+// may contain characters Mermaid treats as syntax.
+func flowchartNode(index int) string {
+	return fmt.Sprintf("step%d", index+1)
+}
+
+func mermaidLabel(text string) string {
+	return `"` + strings.ReplaceAll(text, `"`, "#quot;") + `"`
+}
@@ -1,31 +1,63 @@
 package workflow
 
 import (
+	"bufio"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/coder/quartz"
 	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
 	projectAPI "github.com/orbiqd/orbiqd-projectkit/pkg/project"
 	"github.com/spf13/afero"
 )
 
+const (
+	// executionLockTimeout is how long a writer waits for a writer in another process to release an execution.
+	executionLockTimeout = 5 * time.Second
+
+	// executionLockStaleAge is the age after which a lock file is assumed to be left behind by a crashed process.
+	executionLockStaleAge = time.Minute
+
+	executionLockRetryInterval = 10 * time.Millisecond
+
+	// executionLockBreakSuffix names the file that serializes breaking the stale lock of an execution.
+	executionLockBreakSuffix = ".break"
+
+	// workflowVersionsDir keeps the workflow versions unfinished executions run, as <id>/<version>.json.
+	workflowVersionsDir = "versions"
+)
+
+// FsRepository stores workflows and executions as JSON files. The mutex guards against concurrent access within
+// the process; executions are additionally guarded by lock files, since several processes (e.g. the MCP servers
+// of different agents) may share them.
 type FsRepository struct {
 	mutex       sync.RWMutex
 	workflowFs  afero.Fs
 	executionFs afero.Fs
+	clock       quartz.Clock
+
+	lockTimeout  time.Duration
+	lockStaleAge time.Duration
 }
 
 var _ workflowAPI.Repository = (*FsRepository)(nil)
 
-func NewFsRepository(workflowFs afero.Fs, executionFs afero.Fs) *FsRepository {
+func NewFsRepository(workflowFs afero.Fs, executionFs afero.Fs, clock quartz.Clock) *FsRepository {
 	return &FsRepository{
 		mutex:       sync.RWMutex{},
 		workflowFs:  workflowFs,
 		executionFs: executionFs,
+		clock:       clock,
+
+		lockTimeout:  executionLockTimeout,
+		lockStaleAge: executionLockStaleAge,
 	}
 }
 
@@ -46,12 +78,20 @@ func NewFsRepositoryProvider() func(projectAPI.Fs) (workflowAPI.Repository, erro
 		workflowFs := afero.NewBasePathFs(projectFs, workflowDir)
 		executionFs := afero.NewBasePathFs(projectFs, executionDir)
 
-		return NewFsRepository(workflowFs, executionFs), nil
+		return NewFsRepository(workflowFs, executionFs, quartz.NewReal()), nil
 	}
 }
 
 func (repository *FsRepository) listWorkflowFiles() ([]string, error) {
-	entries, err := afero.ReadDir(repository.workflowFs, ".")
+	return listJSONFiles(repository.workflowFs)
+}
+
+func (repository *FsRepository) listExecutionFiles() ([]string, error) {
+	return listJSONFiles(repository.executionFs)
+}
+
+func listJSONFiles(fs afero.Fs) ([]string, error) {
+	entries, err := afero.ReadDir(fs, ".")
 	if err != nil {
 		return nil, err
 	}
@@ -145,6 +185,42 @@ func (repository *FsRepository) GetWorkflowById(id workflowAPI.WorkflowId) (*wor
 	repository.mutex.RLock()
 	defer repository.mutex.RUnlock()
 
+	return repository.getWorkflow(id)
+}
+
+func (repository *FsRepository) GetWorkflowVersion(id workflowAPI.WorkflowId, version string) (*workflowAPI.Workflow, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	current, err := repository.getWorkflow(id)
+	if err != nil && !errors.Is(err, workflowAPI.ErrWorkflowNotFound) {
+		return nil, err
+	}
+	if current != nil && (version == "" || current.Metadata.Version == version) {
+		return current, nil
+	}
+	if version == "" {
+		return nil, err
+	}
+
+	filename := workflowVersionFilename(id, version)
+	exists, err := afero.Exists(repository.workflowFs, filename)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("workflow %s version %s: %w", id, version, workflowAPI.ErrWorkflowVersionNotFound)
+	}
+
+	workflow, err := repository.loadWorkflowFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflow, nil
+}
+
+func (repository *FsRepository) getWorkflow(id workflowAPI.WorkflowId) (*workflowAPI.Workflow, error) {
 	if err := id.Validate(); err != nil {
 		return nil, err
 	}
@@ -170,20 +246,118 @@ func (repository *FsRepository) RemoveAllWorkflows() error {
 	repository.mutex.Lock()
 	defer repository.mutex.Unlock()
 
+	runningVersions, err := repository.runningWorkflowVersions()
+	if err != nil {
+		return err
+	}
+
 	files, err := repository.listWorkflowFiles()
 	if err != nil {
 		return err
 	}
 
 	for _, file := range files {
+		workflow, err := repository.loadWorkflowFile(file)
+		if err != nil {
+			return err
+		}
+
+		if runningVersions[workflowVersionFilename(workflow.Metadata.ID, workflow.Metadata.Version)] {
+			if err := repository.keepWorkflowVersion(workflow); err != nil {
+				return err
+			}
+		}
+
 		if err := repository.workflowFs.Remove(file); err != nil {
 			return err
 		}
 	}
 
+	return repository.pruneWorkflowVersions(runningVersions)
+}
+
+// runningWorkflowVersions returns the version filenames of the workflow versions unfinished executions run.
+func (repository *FsRepository) runningWorkflowVersions() (map[string]bool, error) {
+	files, err := repository.listExecutionFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	versions := map[string]bool{}
+	for _, file := range files {
+		execution, err := repository.loadExecutionFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		if execution.Status.IsFinished() || execution.WorkflowVersion == "" {
+			continue
+		}
+
+		versions[workflowVersionFilename(execution.WorkflowId, execution.WorkflowVersion)] = true
+	}
+
+	return versions, nil
+}
+
+func (repository *FsRepository) keepWorkflowVersion(workflow workflowAPI.Workflow) error {
+	if err := repository.workflowFs.MkdirAll(filepath.Join(workflowVersionsDir, string(workflow.Metadata.ID)), 0755); err != nil {
+		return err
+	}
+
+	return repository.saveWorkflowFile(workflowVersionFilename(workflow.Metadata.ID, workflow.Metadata.Version), workflow)
+}
+
+// pruneWorkflowVersions removes the kept workflow versions no unfinished execution runs anymore.
+func (repository *FsRepository) pruneWorkflowVersions(runningVersions map[string]bool) error {
+	exists, err := afero.DirExists(repository.workflowFs, workflowVersionsDir)
+	if err != nil || !exists {
+		return err
+	}
+
+	workflowDirs, err := afero.ReadDir(repository.workflowFs, workflowVersionsDir)
+	if err != nil {
+		return err
+	}
+
+	for _, workflowDir := range workflowDirs {
+		if !workflowDir.IsDir() {
+			continue
+		}
+
+		dir := filepath.Join(workflowVersionsDir, workflowDir.Name())
+		versionFiles, err := afero.ReadDir(repository.workflowFs, dir)
+		if err != nil {
+			return err
+		}
+
+		kept := 0
+		for _, versionFile := range versionFiles {
+			filename := filepath.Join(dir, versionFile.Name())
+			if runningVersions[filename] {
+				kept++
+				continue
+			}
+
+			if err := repository.workflowFs.Remove(filename); err != nil {
+				return err
+			}
+		}
+
+		if kept == 0 {
+			if err := repository.workflowFs.Remove(dir); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
+func workflowVersionFilename(id workflowAPI.WorkflowId, version string) string {
+	return filepath.Join(workflowVersionsDir, string(id), version+".json")
+}
+
 func (repository *FsRepository) loadExecutionFile(filename string) (workflowAPI.Execution, error) {
 	data, err := afero.ReadFile(repository.executionFs, filename)
 	if err != nil {
@@ -198,13 +372,90 @@ func (repository *FsRepository) loadExecutionFile(filename string) (workflowAPI.
 	return execution, nil
 }
 
+// saveExecutionFile writes the execution to a temporary file first and renames it into place, so that readers in
+// other processes never see a partially written execution.
 func (repository *FsRepository) saveExecutionFile(filename string, execution workflowAPI.Execution) error {
 	data, err := json.Marshal(execution)
 	if err != nil {
 		return err
 	}
 
-	return afero.WriteFile(repository.executionFs, filename, data, 0644)
+	temporaryFilename := filename + ".tmp"
+	if err := afero.WriteFile(repository.executionFs, temporaryFilename, data, 0644); err != nil {
+		return err
+	}
+
+	return repository.executionFs.Rename(temporaryFilename, filename)
+}
+
+// lockExecution creates the lock file of the execution, waiting while another writer holds it, and returns the
+// function that removes it.
+func (repository *FsRepository) lockExecution(id workflowAPI.ExecutionId) (func(), error) {
+	filename := string(id) + ".lock"
+	deadline := repository.clock.Now().Add(repository.lockTimeout)
+
+	for {
+		file, err := repository.executionFs.OpenFile(filename, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			_ = file.Close()
+			return func() { _ = repository.executionFs.Remove(filename) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, err
+		}
+
+		info, err := repository.executionFs.Stat(filename)
+		if err == nil && repository.clock.Since(info.ModTime()) > repository.lockStaleAge {
+			broken, err := repository.breakStaleLock(filename, info.ModTime())
+			if err != nil {
+				return nil, err
+			}
+			if broken {
+				continue
+			}
+		}
+
+		if !repository.clock.Now().Before(deadline) {
+			return nil, fmt.Errorf("execution %s: %w", id, workflowAPI.ErrExecutionLocked)
+		}
+
+		timer := repository.clock.NewTimer(executionLockRetryInterval, "lockExecution")
+		<-timer.C
+	}
+}
+
+// breakStaleLock removes the lock file judged stale by its modification time and reports whether the stale lock
+// is gone. Writers breaking the same lock are serialized by a break file, and the lock is only removed when it is
+// still the one judged stale, so that a lock just taken by another writer is never removed. A break file left
+// behind by a crashed process is removed once it is stale itself.
+func (repository *FsRepository) breakStaleLock(filename string, staleModTime time.Time) (bool, error) {
+	breakFilename := filename + executionLockBreakSuffix
+
+	file, err := repository.executionFs.OpenFile(breakFilename, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if errors.Is(err, os.ErrExist) {
+		info, err := repository.executionFs.Stat(breakFilename)
+		if err == nil && repository.clock.Since(info.ModTime()) > repository.lockStaleAge {
+			_ = repository.executionFs.Remove(breakFilename)
+		}
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	_ = file.Close()
+	defer func() { _ = repository.executionFs.Remove(breakFilename) }()
+
+	info, err := repository.executionFs.Stat(filename)
+	if err != nil || !info.ModTime().Equal(staleModTime) {
+		return true, nil
+	}
+
+	err = repository.executionFs.Remove(filename)
+	if err != nil && !os.IsNotExist(err) {
+		return false, err
+	}
+
+	return true, nil
 }
 
 func (repository *FsRepository) AddExecution(execution workflowAPI.Execution) error {
@@ -227,7 +478,7 @@ func (repository *FsRepository) AddExecution(execution workflowAPI.Execution) er
 	return repository.saveExecutionFile(filename, execution)
 }
 
-func (repository *FsRepository) UpdateExecution(execution workflowAPI.Execution) error {
+func (repository *FsRepository) UpdateExecution(execution workflowAPI.Execution, events []workflowAPI.Event) error {
 	repository.mutex.Lock()
 	defer repository.mutex.Unlock()
 
@@ -244,7 +495,38 @@ func (repository *FsRepository) UpdateExecution(execution workflowAPI.Execution)
 		return workflowAPI.ErrExecutionNotFound
 	}
 
-	return repository.saveExecutionFile(filename, execution)
+	unlock, err := repository.lockExecution(execution.Id)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	stored, err := repository.loadExecutionFile(filename)
+	if err != nil {
+		return err
+	}
+	if stored.Revision != execution.Revision {
+		return fmt.Errorf("execution %s at revision %d, stored at revision %d: %w", execution.Id, execution.Revision, stored.Revision, workflowAPI.ErrExecutionConflict)
+	}
+
+	execution.Revision++
+
+	// The events are appended first and removed again when the execution cannot be stored, so that the history
+	// never misses a stored change nor records one that was not stored.
+	eventsFilename := string(execution.Id) + ".events.jsonl"
+	size, err := repository.appendEventsFile(eventsFilename, events)
+	if err != nil {
+		return err
+	}
+
+	if err := repository.saveExecutionFile(filename, execution); err != nil {
+		if truncateErr := repository.truncateEventsFile(eventsFilename, size); truncateErr != nil {
+			return errors.Join(err, truncateErr)
+		}
+		return err
+	}
+
+	return nil
 }
 
 func (repository *FsRepository) GetExecutionById(id workflowAPI.ExecutionId) (*workflowAPI.Execution, error) {
@@ -271,3 +553,212 @@ func (repository *FsRepository) GetExecutionById(id workflowAPI.ExecutionId) (*w
 
 	return &execution, nil
 }
+
+func (repository *FsRepository) GetAllExecutions() ([]workflowAPI.Execution, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	files, err := repository.listExecutionFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	executions := make([]workflowAPI.Execution, 0, len(files))
+	for _, file := range files {
+		execution, err := repository.loadExecutionFile(file)
+		if err != nil {
+			return nil, err
+		}
+		executions = append(executions, execution)
+	}
+
+	sort.Slice(executions, func(i, j int) bool {
+		return executions[i].Id < executions[j].Id
+	})
+
+	return executions, nil
+}
+
+func (repository *FsRepository) ListExecutions(workflowId workflowAPI.WorkflowId) ([]workflowAPI.Execution, error) {
+	if err := workflowId.Validate(); err != nil {
+		return nil, err
+	}
+
+	executions, err := repository.GetAllExecutions()
+	if err != nil {
+		return nil, err
+	}
+
+	matching := make([]workflowAPI.Execution, 0, len(executions))
+	for _, execution := range executions {
+		if execution.WorkflowId == workflowId {
+			matching = append(matching, execution)
+		}
+	}
+
+	return matching, nil
+}
+
+// RemoveExecution removes the execution and its history. The execution is locked first, so that it is not
+// removed while another process writes it.
+func (repository *FsRepository) RemoveExecution(id workflowAPI.ExecutionId) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	if err := repository.checkExecutionExists(id); err != nil {
+		return err
+	}
+
+	unlock, err := repository.lockExecution(id)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	eventsFilename := string(id) + ".events.jsonl"
+	exists, err := afero.Exists(repository.executionFs, eventsFilename)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if err := repository.executionFs.Remove(eventsFilename); err != nil {
+			return err
+		}
+	}
+
+	return repository.executionFs.Remove(string(id) + ".json")
+}
+
+// AppendEvent appends the event to the history of the execution. The history is kept in a JSON Lines file
+// next to the execution and is never rewritten.
+func (repository *FsRepository) AppendEvent(id workflowAPI.ExecutionId, event workflowAPI.Event) error {
+	repository.mutex.Lock()
+	defer repository.mutex.Unlock()
+
+	if err := repository.checkExecutionExists(id); err != nil {
+		return err
+	}
+
+	unlock, err := repository.lockExecution(id)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	_, err = repository.appendEventsFile(string(id)+".events.jsonl", []workflowAPI.Event{event})
+
+	return err
+}
+
+// appendEventsFile appends the events to the history file in a single write and returns the size the file had
+// before, so that the append can be undone. A write that fails halfway is undone right away.
+func (repository *FsRepository) appendEventsFile(filename string, events []workflowAPI.Event) (int64, error) {
+	var data []byte
+	for _, event := range events {
+		line, err := json.Marshal(event)
+		if err != nil {
+			return 0, err
+		}
+		data = append(append(data, line...), '\n')
+	}
+
+	file, err := repository.executionFs.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return 0, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return 0, err
+	}
+	size := info.Size()
+
+	if len(data) == 0 {
+		return size, file.Close()
+	}
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Truncate(size)
+		_ = file.Close()
+		return 0, err
+	}
+
+	return size, file.Close()
+}
+
+// truncateEventsFile cuts the history file back to the given size, dropping the events appended after it.
+func (repository *FsRepository) truncateEventsFile(filename string, size int64) error {
+	file, err := repository.executionFs.OpenFile(filename, os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	if err := file.Truncate(size); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// GetEvents returns the history of the execution in the order the events were appended.
+func (repository *FsRepository) GetEvents(id workflowAPI.ExecutionId) ([]workflowAPI.Event, error) {
+	repository.mutex.RLock()
+	defer repository.mutex.RUnlock()
+
+	if err := repository.checkExecutionExists(id); err != nil {
+		return nil, err
+	}
+
+	filename := string(id) + ".events.jsonl"
+	exists, err := afero.Exists(repository.executionFs, filename)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return []workflowAPI.Event{}, nil
+	}
+
+	file, err := repository.executionFs.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	events := []workflowAPI.Event{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var event workflowAPI.Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", filename, len(events)+1, err)
+		}
+		events = append(events, event)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (repository *FsRepository) checkExecutionExists(id workflowAPI.ExecutionId) error {
+	if err := id.Validate(); err != nil {
+		return err
+	}
+
+	exists, err := afero.Exists(repository.executionFs, string(id)+".json")
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return workflowAPI.ErrExecutionNotFound
+	}
+
+	return nil
+}
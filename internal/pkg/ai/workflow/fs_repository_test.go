@@ -1,11 +1,15 @@
 package workflow
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"io/fs"
 	"testing"
+	"time"
 
+	"github.com/coder/quartz"
 	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
@@ -17,7 +21,7 @@ func TestFsRepository_AddWorkflow_WhenNewWorkflow_ThenStoresSuccessfully(t *test
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 	workflow := workflowAPI.Workflow{
 		Metadata: workflowAPI.Metadata{
 			ID:          workflowAPI.WorkflowId("test-workflow"),
@@ -49,7 +53,7 @@ func TestFsRepository_AddWorkflow_WhenDuplicateID_ThenReturnsAlreadyExistsError(
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 	workflow := workflowAPI.Workflow{
 		Metadata: workflowAPI.Metadata{
 			ID:          workflowAPI.WorkflowId("duplicate-workflow"),
@@ -95,7 +99,7 @@ func TestFsRepository_AddWorkflow_WhenCalled_ThenCreatesFileWithIDBasedName(t *t
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 	workflow := workflowAPI.Workflow{
 		Metadata: workflowAPI.Metadata{
 			ID:          workflowAPI.WorkflowId("my-workflow-id"),
@@ -128,7 +132,7 @@ func TestFsRepository_AddWorkflow_WhenCalled_ThenStoresValidJson(t *testing.T) {
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 	workflow := workflowAPI.Workflow{
 		Metadata: workflowAPI.Metadata{
 			ID:          workflowAPI.WorkflowId("test-workflow"),
@@ -164,7 +168,7 @@ func TestFsRepository_AddWorkflow_WhenInvalidID_ThenReturnsInvalidIDError(t *tes
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 	workflow := workflowAPI.Workflow{
 		Metadata: workflowAPI.Metadata{
 			ID:          workflowAPI.WorkflowId("invalid/id"),
@@ -196,7 +200,7 @@ func TestFsRepository_AddWorkflow_WhenExistsFails_ThenReturnsError(t *testing.T)
 			return nil, existsErr
 		},
 	})
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 	workflow := workflowAPI.Workflow{
 		Metadata: workflowAPI.Metadata{
 			ID:          workflowAPI.WorkflowId("test-workflow"),
@@ -229,7 +233,7 @@ func TestFsRepository_AddWorkflow_WhenWriteFileFails_ThenReturnsError(t *testing
 			return nil, writeFileErr
 		},
 	})
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 	workflow := workflowAPI.Workflow{
 		Metadata: workflowAPI.Metadata{
 			ID:          workflowAPI.WorkflowId("test-workflow"),
@@ -256,7 +260,7 @@ func TestFsRepository_GetAll_WhenEmpty_ThenReturnsEmptySlice(t *testing.T) {
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 
 	workflows, err := repo.GetAllWorkflows()
 	require.NoError(t, err)
@@ -268,7 +272,7 @@ func TestFsRepository_GetAll_WhenSingleWorkflow_ThenReturnsWorkflow(t *testing.T
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 	workflow := workflowAPI.Workflow{
 		Metadata: workflowAPI.Metadata{
 			ID:          workflowAPI.WorkflowId("test-workflow"),
@@ -300,7 +304,7 @@ func TestFsRepository_GetAll_WhenMultipleWorkflows_ThenReturnsSortedByName(t *te
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 
 	workflow1 := workflowAPI.Workflow{
 		Metadata: workflowAPI.Metadata{
@@ -382,7 +386,7 @@ func TestFsRepository_GetAll_WhenReadDirFails_ThenReturnsError(t *testing.T) {
 			return base.Open(name)
 		},
 	})
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 
 	result, err := repo.GetAllWorkflows()
 
@@ -424,7 +428,7 @@ func TestFsRepository_GetAll_WhenReadFileFails_ThenReturnsError(t *testing.T) {
 			return base.Open(name)
 		},
 	})
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 
 	result, err := repo.GetAllWorkflows()
 
@@ -436,7 +440,7 @@ func TestFsRepository_GetWorkflowById_WhenWorkflowExists_ThenReturnsWorkflow(t *
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 	workflow := workflowAPI.Workflow{
 		Metadata: workflowAPI.Metadata{
 			ID:          workflowAPI.WorkflowId("existing-workflow"),
@@ -469,7 +473,7 @@ func TestFsRepository_GetWorkflowById_WhenWorkflowNotFound_ThenReturnsError(t *t
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 	workflow := workflowAPI.Workflow{
 		Metadata: workflowAPI.Metadata{
 			ID:          workflowAPI.WorkflowId("some-workflow"),
@@ -499,7 +503,7 @@ func TestFsRepository_GetWorkflowById_WhenEmptyRepository_ThenReturnsError(t *te
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 
 	result, err := repo.GetWorkflowById(workflowAPI.WorkflowId("any-workflow"))
 	require.ErrorIs(t, err, workflowAPI.ErrWorkflowNotFound)
@@ -510,7 +514,7 @@ func TestFsRepository_GetWorkflowById_WhenInvalidID_ThenReturnsInvalidIDError(t
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 
 	result, err := repo.GetWorkflowById(workflowAPI.WorkflowId("invalid/id"))
 	require.ErrorIs(t, err, workflowAPI.ErrWorkflowInvalidID)
@@ -551,7 +555,7 @@ func TestFsRepository_GetWorkflowById_WhenReadFileFails_ThenReturnsError(t *test
 			return base.Open(name)
 		},
 	})
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 
 	result, err := repo.GetWorkflowById(workflowAPI.WorkflowId("test-workflow"))
 
@@ -563,7 +567,7 @@ func TestFsRepository_RemoveAll_WhenEmpty_ThenSucceeds(t *testing.T) {
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 
 	err := repo.RemoveAllWorkflows()
 	require.NoError(t, err)
@@ -577,7 +581,7 @@ func TestFsRepository_RemoveAll_WhenHasWorkflows_ThenRemovesAll(t *testing.T) {
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 	workflow1 := workflowAPI.Workflow{
 		Metadata: workflowAPI.Metadata{
 			ID:          workflowAPI.WorkflowId("workflow1"),
@@ -632,7 +636,7 @@ func TestFsRepository_RemoveAll_WhenFollowedByAdd_ThenAcceptsNewWorkflow(t *test
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 	oldWorkflow := workflowAPI.Workflow{
 		Metadata: workflowAPI.Metadata{
 			ID:          workflowAPI.WorkflowId("new-workflow"),
@@ -696,7 +700,7 @@ func TestFsRepository_RemoveAll_WhenReadDirFails_ThenReturnsError(t *testing.T)
 			return base.Open(name)
 		},
 	})
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 
 	err := repo.RemoveAllWorkflows()
 
@@ -734,18 +738,105 @@ func TestFsRepository_RemoveAll_WhenRemoveFails_ThenReturnsError(t *testing.T) {
 			return removeErr
 		},
 	})
-	repo := NewFsRepository(fs, afero.NewMemMapFs())
+	repo := NewFsRepository(fs, afero.NewMemMapFs(), quartz.NewMock(t))
 
 	err = repo.RemoveAllWorkflows()
 
 	require.ErrorIs(t, err, removeErr)
 }
 
+func versionedTestWorkflow(version string) workflowAPI.Workflow {
+	return workflowAPI.Workflow{
+		Metadata: workflowAPI.Metadata{
+			ID:          workflowAPI.WorkflowId("release"),
+			Name:        "Release " + version,
+			Description: "Release workflow",
+			Version:     version,
+		},
+		Steps: []workflowAPI.Step{
+			{ID: "step1", Name: "Step 1", Description: "First step", Instructions: []string{"Do something"}},
+		},
+	}
+}
+
+func TestFsRepository_RemoveAll_WhenUnfinishedExecutionRunsWorkflow_ThenKeepsItsVersion(t *testing.T) {
+	t.Parallel()
+
+	repo := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+	require.NoError(t, repo.AddWorkflow(versionedTestWorkflow("1.0.0")))
+	require.NoError(t, repo.AddExecution(workflowAPI.Execution{
+		Id: "exec-1", WorkflowId: "release", WorkflowVersion: "1.0.0", Status: workflowAPI.ExecutionStatusRunning, StepId: "step1",
+	}))
+
+	require.NoError(t, repo.RemoveAllWorkflows())
+	require.NoError(t, repo.AddWorkflow(versionedTestWorkflow("2.0.0")))
+
+	kept, err := repo.GetWorkflowVersion("release", "1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, "Release 1.0.0", kept.Metadata.Name)
+
+	current, err := repo.GetWorkflowVersion("release", "2.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, "Release 2.0.0", current.Metadata.Name)
+
+	workflows, err := repo.GetAllWorkflows()
+	require.NoError(t, err)
+	require.Len(t, workflows, 1)
+	assert.Equal(t, "2.0.0", workflows[0].Metadata.Version)
+}
+
+func TestFsRepository_RemoveAll_WhenExecutionsFinished_ThenDropsKeptVersions(t *testing.T) {
+	t.Parallel()
+
+	workflowFs := afero.NewMemMapFs()
+	repo := NewFsRepository(workflowFs, afero.NewMemMapFs(), quartz.NewMock(t))
+	require.NoError(t, repo.AddWorkflow(versionedTestWorkflow("1.0.0")))
+	execution := workflowAPI.Execution{
+		Id: "exec-1", WorkflowId: "release", WorkflowVersion: "1.0.0", Status: workflowAPI.ExecutionStatusRunning, StepId: "step1",
+	}
+	require.NoError(t, repo.AddExecution(execution))
+	require.NoError(t, repo.RemoveAllWorkflows())
+	require.NoError(t, repo.AddWorkflow(versionedTestWorkflow("2.0.0")))
+
+	execution.Status = workflowAPI.ExecutionStatusCompleted
+	require.NoError(t, repo.UpdateExecution(execution, nil))
+	require.NoError(t, repo.RemoveAllWorkflows())
+
+	_, err := repo.GetWorkflowVersion("release", "1.0.0")
+	require.ErrorIs(t, err, workflowAPI.ErrWorkflowVersionNotFound)
+
+	exists, err := afero.DirExists(workflowFs, "versions/release")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestFsRepository_GetWorkflowVersion_WhenVersionEmpty_ThenReturnsCurrentWorkflow(t *testing.T) {
+	t.Parallel()
+
+	repo := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+	require.NoError(t, repo.AddWorkflow(versionedTestWorkflow("1.0.0")))
+
+	workflow, err := repo.GetWorkflowVersion("release", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.0", workflow.Metadata.Version)
+}
+
+func TestFsRepository_GetWorkflowVersion_WhenWorkflowNotFound_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	repo := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+
+	_, err := repo.GetWorkflowVersion("release", "")
+
+	require.ErrorIs(t, err, workflowAPI.ErrWorkflowNotFound)
+}
+
 func TestFsRepository_AddExecution_WhenNewExecution_ThenStoresSuccessfully(t *testing.T) {
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(afero.NewMemMapFs(), fs)
+	repo := NewFsRepository(afero.NewMemMapFs(), fs, quartz.NewMock(t))
 	execution := workflowAPI.Execution{
 		Id:         workflowAPI.ExecutionId("test-execution"),
 		WorkflowId: workflowAPI.WorkflowId("test-workflow"),
@@ -769,7 +860,7 @@ func TestFsRepository_AddExecution_WhenDuplicateID_ThenReturnsAlreadyExistsError
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(afero.NewMemMapFs(), fs)
+	repo := NewFsRepository(afero.NewMemMapFs(), fs, quartz.NewMock(t))
 	execution := workflowAPI.Execution{
 		Id:         workflowAPI.ExecutionId("duplicate-execution"),
 		WorkflowId: workflowAPI.WorkflowId("test-workflow"),
@@ -799,7 +890,7 @@ func TestFsRepository_AddExecution_WhenInvalidID_ThenReturnsInvalidIDError(t *te
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(afero.NewMemMapFs(), fs)
+	repo := NewFsRepository(afero.NewMemMapFs(), fs, quartz.NewMock(t))
 	execution := workflowAPI.Execution{
 		Id:         workflowAPI.ExecutionId("invalid/id"),
 		WorkflowId: workflowAPI.WorkflowId("test-workflow"),
@@ -823,7 +914,7 @@ func TestFsRepository_AddExecution_WhenExistsFails_ThenReturnsError(t *testing.T
 			return nil, existsErr
 		},
 	})
-	repo := NewFsRepository(afero.NewMemMapFs(), fs)
+	repo := NewFsRepository(afero.NewMemMapFs(), fs, quartz.NewMock(t))
 	execution := workflowAPI.Execution{
 		Id:         workflowAPI.ExecutionId("test-execution"),
 		WorkflowId: workflowAPI.WorkflowId("test-workflow"),
@@ -848,7 +939,7 @@ func TestFsRepository_AddExecution_WhenWriteFileFails_ThenReturnsError(t *testin
 			return nil, writeFileErr
 		},
 	})
-	repo := NewFsRepository(afero.NewMemMapFs(), fs)
+	repo := NewFsRepository(afero.NewMemMapFs(), fs, quartz.NewMock(t))
 	execution := workflowAPI.Execution{
 		Id:         workflowAPI.ExecutionId("test-execution"),
 		WorkflowId: workflowAPI.WorkflowId("test-workflow"),
@@ -867,7 +958,7 @@ func TestFsRepository_UpdateExecution_WhenExecutionExists_ThenUpdatesSuccessfull
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(afero.NewMemMapFs(), fs)
+	repo := NewFsRepository(afero.NewMemMapFs(), fs, quartz.NewMock(t))
 	execution := workflowAPI.Execution{
 		Id:         workflowAPI.ExecutionId("test-execution"),
 		WorkflowId: workflowAPI.WorkflowId("test-workflow"),
@@ -885,7 +976,7 @@ func TestFsRepository_UpdateExecution_WhenExecutionExists_ThenUpdatesSuccessfull
 	}
 	execution.StepId = "step2"
 
-	err = repo.UpdateExecution(execution)
+	err = repo.UpdateExecution(execution, nil)
 	require.NoError(t, err)
 
 	result, err := repo.GetExecutionById(workflowAPI.ExecutionId("test-execution"))
@@ -898,7 +989,7 @@ func TestFsRepository_UpdateExecution_WhenExecutionNotFound_ThenReturnsError(t *
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(afero.NewMemMapFs(), fs)
+	repo := NewFsRepository(afero.NewMemMapFs(), fs, quartz.NewMock(t))
 	execution := workflowAPI.Execution{
 		Id:         workflowAPI.ExecutionId("non-existing"),
 		WorkflowId: workflowAPI.WorkflowId("test-workflow"),
@@ -908,7 +999,7 @@ func TestFsRepository_UpdateExecution_WhenExecutionNotFound_ThenReturnsError(t *
 		StepId: "step1",
 	}
 
-	err := repo.UpdateExecution(execution)
+	err := repo.UpdateExecution(execution, nil)
 	require.ErrorIs(t, err, workflowAPI.ErrExecutionNotFound)
 }
 
@@ -916,7 +1007,7 @@ func TestFsRepository_UpdateExecution_WhenInvalidID_ThenReturnsInvalidIDError(t
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(afero.NewMemMapFs(), fs)
+	repo := NewFsRepository(afero.NewMemMapFs(), fs, quartz.NewMock(t))
 	execution := workflowAPI.Execution{
 		Id:         workflowAPI.ExecutionId("invalid/id"),
 		WorkflowId: workflowAPI.WorkflowId("test-workflow"),
@@ -926,15 +1017,245 @@ func TestFsRepository_UpdateExecution_WhenInvalidID_ThenReturnsInvalidIDError(t
 		StepId: "step1",
 	}
 
-	err := repo.UpdateExecution(execution)
+	err := repo.UpdateExecution(execution, nil)
 	require.ErrorIs(t, err, workflowAPI.ErrExecutionInvalidID)
 }
 
+func TestFsRepository_UpdateExecution_WhenUpdated_ThenIncrementsRevision(t *testing.T) {
+	t.Parallel()
+
+	repo := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+	execution := workflowAPI.Execution{Id: "test-execution", WorkflowId: "test-workflow", StepId: "step1"}
+	require.NoError(t, repo.AddExecution(execution))
+
+	require.NoError(t, repo.UpdateExecution(execution, nil))
+
+	result, err := repo.GetExecutionById("test-execution")
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Revision)
+
+	require.NoError(t, repo.UpdateExecution(*result, nil))
+
+	result, err = repo.GetExecutionById("test-execution")
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Revision)
+}
+
+func TestFsRepository_UpdateExecution_WhenEventsGiven_ThenAppendsThemToHistory(t *testing.T) {
+	t.Parallel()
+
+	repo := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+	execution := workflowAPI.Execution{Id: "test-execution", WorkflowId: "test-workflow", StepId: "step1"}
+	require.NoError(t, repo.AddExecution(execution))
+	require.NoError(t, repo.AppendEvent("test-execution", workflowAPI.Event{Type: workflowAPI.EventTypeStarted}))
+
+	execution.StepId = "step2"
+	events := []workflowAPI.Event{
+		{Type: workflowAPI.EventTypeStepCompleted, StepId: "step1"},
+		{Type: workflowAPI.EventTypeStepEntered, StepId: "step2"},
+	}
+	require.NoError(t, repo.UpdateExecution(execution, events))
+
+	result, err := repo.GetEvents("test-execution")
+	require.NoError(t, err)
+	assert.Equal(t, append([]workflowAPI.Event{{Type: workflowAPI.EventTypeStarted}}, events...), result)
+}
+
+func TestFsRepository_UpdateExecution_WhenStoringFails_ThenDropsAppendedEvents(t *testing.T) {
+	t.Parallel()
+
+	base := afero.NewMemMapFs()
+	failRename := false
+	executionFs := aferomock.OverrideFs(base, aferomock.FsCallbacks{
+		RenameFunc: func(oldname string, newname string) error {
+			if failRename {
+				return errors.New("simulated rename error")
+			}
+			return base.Rename(oldname, newname)
+		},
+	})
+	repo := NewFsRepository(afero.NewMemMapFs(), executionFs, quartz.NewMock(t))
+	execution := workflowAPI.Execution{Id: "test-execution", WorkflowId: "test-workflow", StepId: "step1"}
+	require.NoError(t, repo.AddExecution(execution))
+	require.NoError(t, repo.AppendEvent("test-execution", workflowAPI.Event{Type: workflowAPI.EventTypeStarted}))
+
+	failRename = true
+	execution.StepId = "step2"
+	err := repo.UpdateExecution(execution, []workflowAPI.Event{{Type: workflowAPI.EventTypeStepEntered, StepId: "step2"}})
+
+	require.ErrorContains(t, err, "simulated rename error")
+	events, err := repo.GetEvents("test-execution")
+	require.NoError(t, err)
+	assert.Equal(t, []workflowAPI.Event{{Type: workflowAPI.EventTypeStarted}}, events)
+	stored, err := repo.GetExecutionById("test-execution")
+	require.NoError(t, err)
+	assert.Equal(t, "step1", stored.StepId)
+}
+
+func TestFsRepository_UpdateExecution_WhenConflict_ThenAppendsNoEvents(t *testing.T) {
+	t.Parallel()
+
+	repo := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+	execution := workflowAPI.Execution{Id: "test-execution", WorkflowId: "test-workflow", StepId: "step1"}
+	require.NoError(t, repo.AddExecution(execution))
+	require.NoError(t, repo.UpdateExecution(execution, nil))
+
+	err := repo.UpdateExecution(execution, []workflowAPI.Event{{Type: workflowAPI.EventTypeStepEntered, StepId: "step2"}})
+
+	require.ErrorIs(t, err, workflowAPI.ErrExecutionConflict)
+	events, err := repo.GetEvents("test-execution")
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func TestFsRepository_UpdateExecution_WhenUpdatedConcurrently_ThenReturnsConflictError(t *testing.T) {
+	t.Parallel()
+
+	executionFs := afero.NewMemMapFs()
+	first := NewFsRepository(afero.NewMemMapFs(), executionFs, quartz.NewMock(t))
+	second := NewFsRepository(afero.NewMemMapFs(), executionFs, quartz.NewMock(t))
+	require.NoError(t, first.AddExecution(workflowAPI.Execution{Id: "test-execution", WorkflowId: "test-workflow", StepId: "step1"}))
+
+	firstRead, err := first.GetExecutionById("test-execution")
+	require.NoError(t, err)
+	secondRead, err := second.GetExecutionById("test-execution")
+	require.NoError(t, err)
+
+	firstRead.StepId = "step2"
+	require.NoError(t, first.UpdateExecution(*firstRead, nil))
+
+	secondRead.StepId = "step3"
+	err = second.UpdateExecution(*secondRead, nil)
+
+	require.ErrorIs(t, err, workflowAPI.ErrExecutionConflict)
+
+	result, err := first.GetExecutionById("test-execution")
+	require.NoError(t, err)
+	assert.Equal(t, "step2", result.StepId)
+}
+
+func TestFsRepository_UpdateExecution_WhenLockedByAnotherWriter_ThenReturnsLockedError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	clock := quartz.NewMock(t)
+	trap := clock.Trap().NewTimer("lockExecution")
+	defer trap.Close()
+
+	executionFs := afero.NewMemMapFs()
+	repo := NewFsRepository(afero.NewMemMapFs(), executionFs, clock)
+	repo.lockTimeout = 2 * executionLockRetryInterval
+	execution := workflowAPI.Execution{Id: "test-execution", WorkflowId: "test-workflow", StepId: "step1"}
+	require.NoError(t, repo.AddExecution(execution))
+	require.NoError(t, afero.WriteFile(executionFs, "test-execution.lock", nil, 0644))
+	require.NoError(t, executionFs.Chtimes("test-execution.lock", clock.Now(), clock.Now()))
+
+	errs := make(chan error, 1)
+	go func() { errs <- repo.UpdateExecution(execution, nil) }()
+
+	for range 2 {
+		call := trap.MustWait(ctx)
+		call.Release()
+		clock.Advance(executionLockRetryInterval).MustWait(ctx)
+	}
+
+	require.ErrorIs(t, <-errs, workflowAPI.ErrExecutionLocked)
+}
+
+func TestFsRepository_UpdateExecution_WhenLockIsStale_ThenTakesOverLock(t *testing.T) {
+	t.Parallel()
+
+	clock := quartz.NewMock(t)
+	executionFs := afero.NewMemMapFs()
+	repo := NewFsRepository(afero.NewMemMapFs(), executionFs, clock)
+	execution := workflowAPI.Execution{Id: "test-execution", WorkflowId: "test-workflow", StepId: "step1"}
+	require.NoError(t, repo.AddExecution(execution))
+	require.NoError(t, afero.WriteFile(executionFs, "test-execution.lock", nil, 0644))
+	staleTime := clock.Now().Add(-2 * executionLockStaleAge)
+	require.NoError(t, executionFs.Chtimes("test-execution.lock", staleTime, staleTime))
+
+	err := repo.UpdateExecution(execution, nil)
+
+	require.NoError(t, err)
+	for _, filename := range []string{"test-execution.lock", "test-execution.lock.break"} {
+		exists, err := afero.Exists(executionFs, filename)
+		require.NoError(t, err)
+		assert.False(t, exists, filename)
+	}
+}
+
+func TestFsRepository_UpdateExecution_WhenLockBecomesStaleWhileWaiting_ThenTakesOverLock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	clock := quartz.NewMock(t)
+	trap := clock.Trap().NewTimer("lockExecution")
+	defer trap.Close()
+
+	executionFs := afero.NewMemMapFs()
+	repo := NewFsRepository(afero.NewMemMapFs(), executionFs, clock)
+	repo.lockStaleAge = executionLockRetryInterval
+	execution := workflowAPI.Execution{Id: "test-execution", WorkflowId: "test-workflow", StepId: "step1"}
+	require.NoError(t, repo.AddExecution(execution))
+	require.NoError(t, afero.WriteFile(executionFs, "test-execution.lock", nil, 0644))
+	require.NoError(t, executionFs.Chtimes("test-execution.lock", clock.Now(), clock.Now()))
+
+	errs := make(chan error, 1)
+	go func() { errs <- repo.UpdateExecution(execution, nil) }()
+
+	for range 2 {
+		call := trap.MustWait(ctx)
+		call.Release()
+		clock.Advance(executionLockRetryInterval).MustWait(ctx)
+	}
+
+	require.NoError(t, <-errs)
+}
+
+func TestFsRepository_breakStaleLock_WhenLockReplacedByAnotherWriter_ThenKeepsLock(t *testing.T) {
+	t.Parallel()
+
+	clock := quartz.NewMock(t)
+	executionFs := afero.NewMemMapFs()
+	repo := NewFsRepository(afero.NewMemMapFs(), executionFs, clock)
+	require.NoError(t, afero.WriteFile(executionFs, "test-execution.lock", nil, 0644))
+	require.NoError(t, executionFs.Chtimes("test-execution.lock", clock.Now(), clock.Now()))
+
+	broken, err := repo.breakStaleLock("test-execution.lock", clock.Now().Add(-2*executionLockStaleAge))
+
+	require.NoError(t, err)
+	assert.True(t, broken)
+	exists, err := afero.Exists(executionFs, "test-execution.lock")
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestFsRepository_breakStaleLock_WhenAnotherWriterBreaksLock_ThenWaits(t *testing.T) {
+	t.Parallel()
+
+	clock := quartz.NewMock(t)
+	executionFs := afero.NewMemMapFs()
+	repo := NewFsRepository(afero.NewMemMapFs(), executionFs, clock)
+	staleTime := clock.Now().Add(-2 * executionLockStaleAge)
+	require.NoError(t, afero.WriteFile(executionFs, "test-execution.lock", nil, 0644))
+	require.NoError(t, executionFs.Chtimes("test-execution.lock", staleTime, staleTime))
+	require.NoError(t, afero.WriteFile(executionFs, "test-execution.lock.break", nil, 0644))
+	require.NoError(t, executionFs.Chtimes("test-execution.lock.break", clock.Now(), clock.Now()))
+
+	broken, err := repo.breakStaleLock("test-execution.lock", staleTime)
+
+	require.NoError(t, err)
+	assert.False(t, broken)
+	exists, err := afero.Exists(executionFs, "test-execution.lock")
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
 func TestFsRepository_GetExecutionById_WhenExecutionExists_ThenReturnsExecution(t *testing.T) {
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(afero.NewMemMapFs(), fs)
+	repo := NewFsRepository(afero.NewMemMapFs(), fs, quartz.NewMock(t))
 	execution := workflowAPI.Execution{
 		Id:         workflowAPI.ExecutionId("existing-execution"),
 		WorkflowId: workflowAPI.WorkflowId("test-workflow"),
@@ -959,7 +1280,7 @@ func TestFsRepository_GetExecutionById_WhenExecutionNotFound_ThenReturnsError(t
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(afero.NewMemMapFs(), fs)
+	repo := NewFsRepository(afero.NewMemMapFs(), fs, quartz.NewMock(t))
 	execution := workflowAPI.Execution{
 		Id:         workflowAPI.ExecutionId("some-execution"),
 		WorkflowId: workflowAPI.WorkflowId("test-workflow"),
@@ -981,7 +1302,7 @@ func TestFsRepository_GetExecutionById_WhenEmptyRepository_ThenReturnsError(t *t
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(afero.NewMemMapFs(), fs)
+	repo := NewFsRepository(afero.NewMemMapFs(), fs, quartz.NewMock(t))
 
 	result, err := repo.GetExecutionById(workflowAPI.ExecutionId("any-execution"))
 	require.ErrorIs(t, err, workflowAPI.ErrExecutionNotFound)
@@ -992,7 +1313,7 @@ func TestFsRepository_GetExecutionById_WhenInvalidID_ThenReturnsInvalidIDError(t
 	t.Parallel()
 
 	fs := afero.NewMemMapFs()
-	repo := NewFsRepository(afero.NewMemMapFs(), fs)
+	repo := NewFsRepository(afero.NewMemMapFs(), fs, quartz.NewMock(t))
 
 	result, err := repo.GetExecutionById(workflowAPI.ExecutionId("invalid/id"))
 	require.ErrorIs(t, err, workflowAPI.ErrExecutionInvalidID)
@@ -1025,10 +1346,159 @@ func TestFsRepository_GetExecutionById_WhenReadFileFails_ThenReturnsError(t *tes
 			return base.Open(name)
 		},
 	})
-	repo := NewFsRepository(afero.NewMemMapFs(), fs)
+	repo := NewFsRepository(afero.NewMemMapFs(), fs, quartz.NewMock(t))
 
 	result, err := repo.GetExecutionById(workflowAPI.ExecutionId("test-execution"))
 
 	require.ErrorIs(t, err, readFileErr)
 	assert.Nil(t, result)
 }
+
+func TestFsRepository_ListExecutions_WhenExecutionsOfSeveralWorkflows_ThenReturnsOnlyWorkflowExecutions(t *testing.T) {
+	t.Parallel()
+
+	repo := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+	for _, execution := range []workflowAPI.Execution{
+		{Id: "exec-2", WorkflowId: "release", Status: workflowAPI.ExecutionStatusRunning, StepId: "step1"},
+		{Id: "exec-1", WorkflowId: "release", Status: workflowAPI.ExecutionStatusCompleted, StepId: "step1"},
+		{Id: "exec-3", WorkflowId: "hotfix", Status: workflowAPI.ExecutionStatusRunning, StepId: "step1"},
+	} {
+		require.NoError(t, repo.AddExecution(execution))
+	}
+
+	executions, err := repo.ListExecutions("release")
+
+	require.NoError(t, err)
+	require.Len(t, executions, 2)
+	assert.Equal(t, workflowAPI.ExecutionId("exec-1"), executions[0].Id)
+	assert.Equal(t, workflowAPI.ExecutionId("exec-2"), executions[1].Id)
+}
+
+func TestFsRepository_ListExecutions_WhenInvalidID_ThenReturnsInvalidIDError(t *testing.T) {
+	t.Parallel()
+
+	repo := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+
+	_, err := repo.ListExecutions("invalid/id")
+
+	require.ErrorIs(t, err, workflowAPI.ErrWorkflowInvalidID)
+}
+
+func TestFsRepository_RemoveExecution_WhenExecutionExists_ThenRemovesExecutionAndHistory(t *testing.T) {
+	t.Parallel()
+
+	executionFs := afero.NewMemMapFs()
+	repo := NewFsRepository(afero.NewMemMapFs(), executionFs, quartz.NewMock(t))
+	require.NoError(t, repo.AddExecution(workflowAPI.Execution{Id: "exec-1", WorkflowId: "release", Status: workflowAPI.ExecutionStatusCompleted, StepId: "step1"}))
+	require.NoError(t, repo.AppendEvent("exec-1", workflowAPI.Event{Type: workflowAPI.EventTypeStarted}))
+
+	err := repo.RemoveExecution("exec-1")
+
+	require.NoError(t, err)
+	_, err = repo.GetExecutionById("exec-1")
+	require.ErrorIs(t, err, workflowAPI.ErrExecutionNotFound)
+
+	files, err := afero.ReadDir(executionFs, ".")
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestFsRepository_RemoveExecution_WhenExecutionNotFound_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	repo := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+
+	err := repo.RemoveExecution("missing")
+
+	require.ErrorIs(t, err, workflowAPI.ErrExecutionNotFound)
+}
+
+func TestFsRepository_AppendEvent_WhenExecutionExists_ThenKeepsEventsInOrder(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	repo := NewFsRepository(afero.NewMemMapFs(), fs, quartz.NewMock(t))
+	require.NoError(t, repo.AddExecution(workflowAPI.Execution{Id: "test-execution", WorkflowId: "test-workflow", StepId: "step1"}))
+
+	startedAt := time.Date(2025, 3, 14, 9, 30, 0, 0, time.UTC)
+	actor := workflowAPI.Actor{Kind: workflowAPI.ActorKindAgent, Name: "codex"}
+	events := []workflowAPI.Event{
+		{Time: startedAt, Type: workflowAPI.EventTypeStarted, Actor: actor},
+		{Time: startedAt.Add(time.Second), Type: workflowAPI.EventTypeStateChanged, Actor: actor, StepId: "step1", Key: "key", OldValue: "value1", NewValue: "value2"},
+	}
+
+	for _, event := range events {
+		require.NoError(t, repo.AppendEvent("test-execution", event))
+	}
+	require.NoError(t, repo.UpdateExecution(workflowAPI.Execution{Id: "test-execution", WorkflowId: "test-workflow", StepId: "step2"}, nil))
+
+	result, err := repo.GetEvents("test-execution")
+	require.NoError(t, err)
+	assert.Equal(t, events, result)
+
+	data, err := afero.ReadFile(fs, "test-execution.events.jsonl")
+	require.NoError(t, err)
+	assert.Equal(t, 2, bytes.Count(data, []byte("\n")))
+}
+
+func TestFsRepository_AppendEvent_WhenExecutionNotFound_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	repo := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+
+	err := repo.AppendEvent("missing", workflowAPI.Event{Type: workflowAPI.EventTypeStarted})
+
+	require.ErrorIs(t, err, workflowAPI.ErrExecutionNotFound)
+}
+
+func TestFsRepository_GetEvents_WhenNoEventsRecorded_ThenReturnsEmptySlice(t *testing.T) {
+	t.Parallel()
+
+	repo := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+	require.NoError(t, repo.AddExecution(workflowAPI.Execution{Id: "test-execution", WorkflowId: "test-workflow", StepId: "step1"}))
+
+	result, err := repo.GetEvents("test-execution")
+
+	require.NoError(t, err)
+	assert.Empty(t, result)
+	assert.NotNil(t, result)
+}
+
+func TestFsRepository_GetEvents_WhenInvalidID_ThenReturnsInvalidIDError(t *testing.T) {
+	t.Parallel()
+
+	repo := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+
+	_, err := repo.GetEvents("invalid/id")
+
+	require.ErrorIs(t, err, workflowAPI.ErrExecutionInvalidID)
+}
+
+func TestFsRepository_GetAllExecutions_WhenExecutionsStored_ThenReturnsSortedByIdWithoutEvents(t *testing.T) {
+	t.Parallel()
+
+	repo := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+	for _, id := range []workflowAPI.ExecutionId{"exec-b", "exec-a"} {
+		require.NoError(t, repo.AddExecution(workflowAPI.Execution{Id: id, WorkflowId: "test-workflow", StepId: "step1"}))
+		require.NoError(t, repo.AppendEvent(id, workflowAPI.Event{Type: workflowAPI.EventTypeStarted}))
+	}
+
+	result, err := repo.GetAllExecutions()
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, workflowAPI.ExecutionId("exec-a"), result[0].Id)
+	assert.Equal(t, workflowAPI.ExecutionId("exec-b"), result[1].Id)
+}
+
+func TestFsRepository_GetAllExecutions_WhenEmpty_ThenReturnsEmptySlice(t *testing.T) {
+	t.Parallel()
+
+	repo := NewFsRepository(afero.NewMemMapFs(), afero.NewMemMapFs(), quartz.NewMock(t))
+
+	result, err := repo.GetAllExecutions()
+
+	require.NoError(t, err)
+	assert.Empty(t, result)
+	assert.NotNil(t, result)
+}
@@ -0,0 +1,86 @@
+package workflow
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/invopop/jsonschema"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+)
+
+//go:embed workflow.go.tmpl
+var templateContent string
+
+// MarkdownRenderer renders a workflow as Markdown documentation with an embedded Mermaid flowchart.
+type MarkdownRenderer struct {
+}
+
+var _ workflowAPI.Renderer = (*MarkdownRenderer)(nil)
+
+func NewMarkdownRenderer() *MarkdownRenderer {
+	return &MarkdownRenderer{}
+}
+
+var funcMap = template.FuncMap{
+	"add": func(a, b int) int {
+		return a + b
+	},
+	"codeFence": func() string {
+		return "```"
+	},
+	"slugify": func(s string) string {
+		s = strings.ToLower(s)
+		s = strings.ReplaceAll(s, " ", "-")
+		for _, character := range []string{"*", ":", ",", ".", "(", ")", "'", "\"", "`"} {
+			s = strings.ReplaceAll(s, character, "")
+		}
+		return s
+	},
+	"flowchart":   flowchart,
+	"transitions": stepTransitions,
+	"schemaType": func(schema *jsonschema.Schema) string {
+		if schema == nil || schema.Type == "" {
+			return "any"
+		}
+		return schema.Type
+	},
+	"schemaDescription": func(schema *jsonschema.Schema) string {
+		if schema == nil {
+			return ""
+		}
+		return strings.Join(strings.Fields(schema.Description), " ")
+	},
+	"schemaJSON": func(schema *jsonschema.Schema) (string, error) {
+		if schema == nil {
+			return "{}", nil
+		}
+
+		data, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	},
+}
+
+func (renderer *MarkdownRenderer) Render(workflow workflowAPI.Workflow) ([]byte, error) {
+	tmpl, err := template.New("workflow.go.tmpl").Funcs(funcMap).Parse(templateContent)
+	if err != nil {
+		return nil, fmt.Errorf("template parse: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, workflow); err != nil {
+		return nil, fmt.Errorf("template execution: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (renderer *MarkdownRenderer) FileExtension() string {
+	return ".md"
+}
@@ -0,0 +1,157 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/invopop/jsonschema"
+	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func renderTestWorkflow() workflowAPI.Workflow {
+	return workflowAPI.Workflow{
+		Metadata: workflowAPI.Metadata{
+			ID:          "release",
+			Name:        "Release",
+			Description: "Release a new version.",
+			Version:     "1.2.0",
+		},
+		State: map[string]*jsonschema.Schema{
+			"testsPassed": {Type: "boolean", Description: "Whether the test suite passed."},
+			"version":     {Type: "string"},
+		},
+		Steps: []workflowAPI.Step{
+			{
+				ID:           "test",
+				Name:         "Run tests",
+				Description:  "Run the test suite.",
+				Instructions: []string{"Run go test ./...", "Record the result."},
+				Outputs:      []string{"testsPassed"},
+				Transitions:  []workflowAPI.Transition{{When: "testsPassed == false", Next: "fix"}},
+			},
+			{
+				ID:           "publish",
+				Name:         "Publish",
+				Description:  "Publish the release.",
+				Instructions: []string{"Tag the release."},
+				Outputs:      []string{"version"},
+				Terminal:     true,
+				Approval:     &workflowAPI.Approval{Message: "Check the changelog."},
+			},
+			{
+				ID:           "fix",
+				Name:         "Fix",
+				Description:  "Fix failing tests.",
+				Instructions: []string{"Fix the tests."},
+				Transitions:  []workflowAPI.Transition{{Next: "test"}},
+			},
+		},
+	}
+}
+
+func TestMarkdownRenderer_FileExtension_WhenCalled_ThenReturnsMdExtension(t *testing.T) {
+	t.Parallel()
+
+	renderer := NewMarkdownRenderer()
+
+	assert.Equal(t, ".md", renderer.FileExtension())
+}
+
+func TestMarkdownRenderer_Render_WhenWorkflow_ThenRendersMetadata(t *testing.T) {
+	t.Parallel()
+
+	result, err := NewMarkdownRenderer().Render(renderTestWorkflow())
+
+	require.NoError(t, err)
+	assert.Contains(t, string(result), "# Release\n\nRelease a new version.")
+	assert.Contains(t, string(result), "**ID:** `release`")
+	assert.Contains(t, string(result), "**Version:** 1.2.0")
+}
+
+func TestMarkdownRenderer_Render_WhenWorkflow_ThenEmbedsFlowchart(t *testing.T) {
+	t.Parallel()
+
+	result, err := NewMarkdownRenderer().Render(renderTestWorkflow())
+
+	require.NoError(t, err)
+	assert.Contains(t, string(result), "```mermaid\nflowchart TD\n")
+}
+
+func TestMarkdownRenderer_Render_WhenStateDeclared_ThenRendersVariablesWithSchemas(t *testing.T) {
+	t.Parallel()
+
+	result, err := NewMarkdownRenderer().Render(renderTestWorkflow())
+
+	require.NoError(t, err)
+	assert.Contains(t, string(result), "| `testsPassed` | boolean | Whether the test suite passed. |")
+	assert.Contains(t, string(result), "| `version` | string |  |")
+	assert.Contains(t, string(result), "### `version`\n\n```json\n{\n  \"type\": \"string\"\n}\n```")
+}
+
+func TestMarkdownRenderer_Render_WhenStateEmpty_ThenOmitsStateSection(t *testing.T) {
+	t.Parallel()
+
+	workflow := renderTestWorkflow()
+	workflow.State = nil
+
+	result, err := NewMarkdownRenderer().Render(workflow)
+
+	require.NoError(t, err)
+	assert.NotContains(t, string(result), "## State")
+}
+
+func TestMarkdownRenderer_Render_WhenWorkflow_ThenRendersNumberedStepsWithInstructions(t *testing.T) {
+	t.Parallel()
+
+	result, err := NewMarkdownRenderer().Render(renderTestWorkflow())
+
+	require.NoError(t, err)
+	assert.Contains(t, string(result), "### 1. Run tests\n\n**ID:** `test`\n\nRun the test suite.")
+	assert.Contains(t, string(result), "1. Run go test ./...\n2. Record the result.\n")
+	assert.Contains(t, string(result), "**Outputs:** `testsPassed`")
+	assert.Contains(t, string(result), "**Requires approval:** Check the changelog.")
+}
+
+func TestMarkdownRenderer_Render_WhenStepHasTransitions_ThenRendersNextSteps(t *testing.T) {
+	t.Parallel()
+
+	result, err := NewMarkdownRenderer().Render(renderTestWorkflow())
+
+	require.NoError(t, err)
+	assert.Contains(t, string(result), "**Next:**\n- when `testsPassed == false`: 3. Fix (`fix`)\n- 2. Publish (`publish`)\n")
+	assert.Contains(t, string(result), "**Next:**\n- end of workflow\n")
+	assert.Contains(t, string(result), "**Next:**\n- 1. Run tests (`test`)\n")
+}
+
+func TestMarkdownRenderer_Render_WhenStepInvokesWorkflow_ThenRendersInvocation(t *testing.T) {
+	t.Parallel()
+
+	workflow := renderTestWorkflow()
+	workflow.Steps[2].Instructions = nil
+	workflow.Steps[2].Workflow = &workflowAPI.Invocation{
+		WorkflowId: "fix-tests",
+		Inputs:     map[string]string{"version": "version"},
+		Outputs:    map[string]string{"testsPassed": "passed"},
+	}
+
+	result, err := NewMarkdownRenderer().Render(workflow)
+
+	require.NoError(t, err)
+	assert.Contains(t, string(result), "Fix failing tests.\n\n**Runs workflow:** `fix-tests`\n- input `version` from `version`\n- output `testsPassed` from `passed`\n\n**Next:**")
+}
+
+func TestMarkdownRenderer_Render_WhenStepReferencesSkillsAndTools_ThenRendersThem(t *testing.T) {
+	t.Parallel()
+
+	workflow := renderTestWorkflow()
+	workflow.Steps[0].Skills = []skillAPI.Name{"go-testing", "coverage"}
+	workflow.Steps[0].Tools = []toolAPI.ToolId{"go-test"}
+
+	result, err := NewMarkdownRenderer().Render(workflow)
+
+	require.NoError(t, err)
+	assert.Contains(t, string(result), "2. Record the result.\n\n**Skills:** `go-testing`, `coverage`\n\n**Tools:** `go-test`\n\n**Outputs:** `testsPassed`")
+}
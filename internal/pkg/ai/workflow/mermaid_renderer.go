@@ -0,0 +1,21 @@
+package workflow
+
+import workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+
+// MermaidRenderer renders a workflow as a standalone Mermaid flowchart.
+type MermaidRenderer struct {
+}
+
+var _ workflowAPI.Renderer = (*MermaidRenderer)(nil)
+
+func NewMermaidRenderer() *MermaidRenderer {
+	return &MermaidRenderer{}
+}
+
+func (renderer *MermaidRenderer) Render(workflow workflowAPI.Workflow) ([]byte, error) {
+	return []byte(flowchart(workflow)), nil
+}
+
+func (renderer *MermaidRenderer) FileExtension() string {
+	return ".mmd"
+}
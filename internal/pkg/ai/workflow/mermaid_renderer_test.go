@@ -0,0 +1,78 @@
+package workflow
+
+import (
+	"testing"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMermaidRenderer_FileExtension_WhenCalled_ThenReturnsMmdExtension(t *testing.T) {
+	t.Parallel()
+
+	renderer := NewMermaidRenderer()
+
+	assert.Equal(t, ".mmd", renderer.FileExtension())
+}
+
+func TestMermaidRenderer_Render_WhenWorkflow_ThenRendersStepsAndTransitions(t *testing.T) {
+	t.Parallel()
+
+	result, err := NewMermaidRenderer().Render(renderTestWorkflow())
+
+	require.NoError(t, err)
+	assert.Equal(t, `flowchart TD
+    start([Start])
+    step1["1. Run tests"]
+    step2{{"2. Publish"}}
+    step3["3. Fix"]
+    finish([End])
+    start --> step1
+    step1 -->|"testsPassed == false"| step3
+    step1 --> step2
+    step2 --> finish
+    step3 --> step1
+`, string(result))
+}
+
+func TestMermaidRenderer_Render_WhenLabelContainsQuotes_ThenEscapesThem(t *testing.T) {
+	t.Parallel()
+
+	workflow := renderTestWorkflow()
+	workflow.Steps[0].Name = `Run "unit" tests`
+	workflow.Steps[0].Transitions[0].When = `status == "failed"`
+
+	result, err := NewMermaidRenderer().Render(workflow)
+
+	require.NoError(t, err)
+	assert.Contains(t, string(result), `step1["1. Run #quot;unit#quot; tests"]`)
+	assert.Contains(t, string(result), `step1 -->|"status == #quot;failed#quot;"| step3`)
+}
+
+func TestMermaidRenderer_Render_WhenLastStepNotTerminal_ThenEndsAfterIt(t *testing.T) {
+	t.Parallel()
+
+	workflow := workflowAPI.Workflow{
+		Steps: []workflowAPI.Step{
+			{ID: "only", Name: "Only"},
+		},
+	}
+
+	result, err := NewMermaidRenderer().Render(workflow)
+
+	require.NoError(t, err)
+	assert.Contains(t, string(result), "start --> step1\n    step1 --> finish\n")
+}
+
+func TestMermaidRenderer_Render_WhenStepInvokesWorkflow_ThenDrawsSubroutine(t *testing.T) {
+	t.Parallel()
+
+	workflow := renderTestWorkflow()
+	workflow.Steps[2].Workflow = &workflowAPI.Invocation{WorkflowId: "fix-tests"}
+
+	result, err := NewMermaidRenderer().Render(workflow)
+
+	require.NoError(t, err)
+	assert.Contains(t, string(result), `step3[["3. Fix"]]`)
+}
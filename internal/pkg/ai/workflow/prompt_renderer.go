@@ -0,0 +1,73 @@
+package workflow
+
+import (
+	"fmt"
+	"strings"
+
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+	"sigs.k8s.io/yaml"
+)
+
+// PromptRenderer renders a workflow as an agent prompt, e.g. a slash command, that walks the agent through an
+// execution of the workflow using the tools of the projectkit MCP server.
+type PromptRenderer struct {
+	toolReference func(tool string) string
+	marker        string
+}
+
+type promptFrontmatter struct {
+	Description  string `json:"description"`
+	ArgumentHint string `json:"argument-hint"`
+}
+
+var _ workflowAPI.Renderer = (*PromptRenderer)(nil)
+
+// NewPromptRenderer returns a renderer that refers to the MCP tools the way toolReference spells them for the
+// agent, e.g. "the `workflow_start` tool". A non-empty marker is written right after the frontmatter.
+func NewPromptRenderer(toolReference func(tool string) string, marker string) *PromptRenderer {
+	return &PromptRenderer{
+		toolReference: toolReference,
+		marker:        marker,
+	}
+}
+
+func (renderer *PromptRenderer) Render(workflow workflowAPI.Workflow) ([]byte, error) {
+	frontmatter, err := yaml.Marshal(promptFrontmatter{
+		Description:  workflow.Metadata.Description,
+		ArgumentHint: "[context]",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("prompt frontmatter serialization: %w", err)
+	}
+
+	var builder strings.Builder
+
+	builder.WriteString("---\n")
+	builder.Write(frontmatter)
+	builder.WriteString("---\n\n")
+	if renderer.marker != "" {
+		builder.WriteString(renderer.marker + "\n\n")
+	}
+	_, _ = fmt.Fprintf(&builder, "Follow the %q workflow (`%s`, version %s) step by step using the tools of the `projectkit` MCP server.\n\n",
+		workflow.Metadata.Name, workflow.Metadata.ID, workflow.Metadata.Version)
+
+	builder.WriteString("Steps:\n\n")
+	for index, step := range workflow.Steps {
+		_, _ = fmt.Fprintf(&builder, "%d. %s - %s\n", index+1, step.Name, step.Description)
+	}
+
+	builder.WriteString("\nHow to follow the workflow:\n\n")
+	_, _ = fmt.Fprintf(&builder, "1. Start an execution with %s, passing `workflowId` set to `%s`.\n", renderer.toolReference("workflow_start"), workflow.Metadata.ID)
+	_, _ = fmt.Fprintf(&builder, "2. Work on the returned step by following its instructions. Store every output the step declares with %s.\n", renderer.toolReference("workflow_set_state"))
+	_, _ = fmt.Fprintf(&builder, "3. Complete the step with %s and continue with the step it returns.\n", renderer.toolReference("workflow_next_step"))
+	_, _ = fmt.Fprintf(&builder, "4. When the execution is `awaiting-approval`, stop and ask the user to run `projectkit workflow approve <executionId>` or `projectkit workflow reject <executionId>`. Check %s before continuing.\n", renderer.toolReference("workflow_current_step"))
+	_, _ = fmt.Fprintf(&builder, "5. When the execution is `suspended`, its step runs another workflow: continue with the execution in `childExecutionId`. Completing that execution resumes the one in its `parentExecutionId`, continue there using %s.\n", renderer.toolReference("workflow_current_step"))
+	builder.WriteString("6. Repeat until the execution you started is `completed`.\n\n")
+	builder.WriteString("Context from the user: $ARGUMENTS\n")
+
+	return []byte(builder.String()), nil
+}
+
+func (renderer *PromptRenderer) FileExtension() string {
+	return ".md"
+}
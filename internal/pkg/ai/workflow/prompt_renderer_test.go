@@ -0,0 +1,74 @@
+package workflow
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+func testToolReference(tool string) string {
+	return "the `" + tool + "` tool"
+}
+
+func TestPromptRenderer_Render_WhenWorkflow_ThenRendersFrontmatterAndSteps(t *testing.T) {
+	t.Parallel()
+
+	result, err := NewPromptRenderer(testToolReference, "<!-- marker -->").Render(renderTestWorkflow())
+
+	require.NoError(t, err)
+	content := string(result)
+	assert.True(t, strings.HasPrefix(content, "---\nargument-hint: '[context]'\ndescription: "), content)
+	assert.Contains(t, content, "---\n\n<!-- marker -->\n\n")
+	assert.Contains(t, content, "1. Run tests - ")
+	assert.Contains(t, content, "1. Start an execution with the `workflow_start` tool, passing `workflowId` set to `release`.\n")
+	assert.Contains(t, content, "continue there using the `workflow_current_step` tool.\n")
+}
+
+func TestPromptRenderer_Render_WhenMarkerEmpty_ThenOmitsMarker(t *testing.T) {
+	t.Parallel()
+
+	result, err := NewPromptRenderer(testToolReference, "").Render(renderTestWorkflow())
+
+	require.NoError(t, err)
+	assert.Contains(t, string(result), "---\n\nFollow the ")
+}
+
+func TestPromptRenderer_Render_WhenDescriptionHasYAMLSyntax_ThenFrontmatterStaysValid(t *testing.T) {
+	t.Parallel()
+
+	descriptions := []string{
+		"Release: tag and publish",
+		"Runs checks # before merging",
+		`"Quoted" description`,
+		"'Single' quoted: description",
+	}
+
+	for _, description := range descriptions {
+		t.Run(description, func(t *testing.T) {
+			t.Parallel()
+
+			workflow := renderTestWorkflow()
+			workflow.Metadata.Description = description
+
+			result, err := NewPromptRenderer(testToolReference, "").Render(workflow)
+			require.NoError(t, err)
+
+			parts := strings.SplitN(string(result), "---\n", 3)
+			require.Len(t, parts, 3)
+
+			var frontmatter map[string]any
+			require.NoError(t, yaml.Unmarshal([]byte(parts[1]), &frontmatter))
+			assert.Equal(t, description, frontmatter["description"])
+			assert.Equal(t, "[context]", frontmatter["argument-hint"])
+		})
+	}
+}
+
+func TestPromptRenderer_FileExtension_WhenCalled_ThenReturnsMdExtension(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, ".md", NewPromptRenderer(testToolReference, "").FileExtension())
+}
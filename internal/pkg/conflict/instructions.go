@@ -0,0 +1,118 @@
+package conflict
+
+import (
+	"fmt"
+
+	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
+	conflictAPI "github.com/orbiqd/orbiqd-projectkit/pkg/conflict"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+)
+
+type ruleRef struct {
+	set  int
+	rule instructionAPI.Rule
+}
+
+// ResolveInstructions resolves conflicts between instruction sets. Sets sharing a category are merged rather
+// than shadowed, so the policy only decides which set keeps a rule provided more than once within a category;
+// such rules are identical, so they never fail the update. An override for a category keeps only the sets
+// provided by the overriding source.
+func ResolveInstructions(resolver *Resolver, instructions []instructionAPI.Instructions) ([]instructionAPI.Instructions, error) {
+	instructions, err := resolver.applyCategoryOverrides(instructions)
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []ruleRef
+	for set, item := range instructions {
+		for _, rule := range item.Rules {
+			refs = append(refs, ruleRef{set: set, rule: rule})
+		}
+	}
+
+	kept, err := Resolve(resolver, conflictAPI.KindInstruction, refs, func(ref ruleRef) (string, *sourceAPI.Provenance) {
+		return string(instructions[ref.set].Category) + ": " + string(ref.rule), instructionsProvenance(instructions[ref.set])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([][]instructionAPI.Rule, len(instructions))
+	for _, ref := range kept {
+		rules[ref.set] = append(rules[ref.set], ref.rule)
+	}
+
+	var result []instructionAPI.Instructions
+	for set, item := range instructions {
+		if len(rules[set]) == 0 {
+			continue
+		}
+
+		item.Rules = rules[set]
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+func (resolver *Resolver) applyCategoryOverrides(instructions []instructionAPI.Instructions) ([]instructionAPI.Instructions, error) {
+	for _, override := range resolver.config.Overrides {
+		if override.Kind != conflictAPI.KindInstruction {
+			continue
+		}
+
+		var winner *sourceAPI.Provenance
+		found := false
+		for _, item := range instructions {
+			if string(item.Category) != override.Name {
+				continue
+			}
+
+			found = true
+			if provenance := instructionsProvenance(item); provenance != nil && provenance.SourceURI == override.SourceURI {
+				winner = provenance
+				break
+			}
+		}
+
+		if !found {
+			continue
+		}
+		if winner == nil {
+			return nil, fmt.Errorf("%s %s from %s: %w", conflictAPI.KindInstruction, override.Name, override.SourceURI, conflictAPI.ErrOverrideSourceNotFound)
+		}
+
+		var result []instructionAPI.Instructions
+		for _, item := range instructions {
+			provenance := instructionsProvenance(item)
+			if string(item.Category) != override.Name || (provenance != nil && provenance.SourceURI == override.SourceURI) {
+				result = append(result, item)
+				continue
+			}
+
+			shadowed := sourceAPI.Provenance{}
+			if provenance != nil {
+				shadowed = *provenance
+			}
+
+			resolver.shadowed = append(resolver.shadowed, conflictAPI.Shadow{
+				Kind:     conflictAPI.KindInstruction,
+				Name:     override.Name,
+				Winner:   *winner,
+				Shadowed: shadowed,
+			})
+		}
+
+		instructions = result
+	}
+
+	return instructions, nil
+}
+
+func instructionsProvenance(instructions instructionAPI.Instructions) *sourceAPI.Provenance {
+	if len(instructions.Provenance) == 0 {
+		return nil
+	}
+
+	return &instructions.Provenance[0]
+}
@@ -0,0 +1,146 @@
+package conflict
+
+import (
+	"testing"
+
+	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
+	conflictAPI "github.com/orbiqd/orbiqd-projectkit/pkg/conflict"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func instructionSet(category, uri string, rules ...instructionAPI.Rule) instructionAPI.Instructions {
+	return instructionAPI.Instructions{
+		Category:   instructionAPI.Category(category),
+		Rules:      rules,
+		Provenance: []sourceAPI.Provenance{{SourceURI: uri}},
+	}
+}
+
+func TestResolveInstructions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		config       *conflictAPI.Config
+		instructions []instructionAPI.Instructions
+		want         []instructionAPI.Instructions
+		wantShadowed int
+		wantErr      error
+		errorMsg     string
+	}{
+		{
+			name:   "WhenSameCategoryWithDistinctRules_ThenKeepsEverySet",
+			config: nil,
+			instructions: []instructionAPI.Instructions{
+				instructionSet("coding", "local://a", "rule one"),
+				instructionSet("coding", "local://b", "rule two"),
+			},
+			want: []instructionAPI.Instructions{
+				instructionSet("coding", "local://a", "rule one"),
+				instructionSet("coding", "local://b", "rule two"),
+			},
+		},
+		{
+			name:   "WhenDuplicateRuleAndDefaultPolicy_ThenKeepsFirstRule",
+			config: nil,
+			instructions: []instructionAPI.Instructions{
+				instructionSet("coding", "local://a", "rule one"),
+				instructionSet("coding", "local://b", "rule one"),
+			},
+			want: []instructionAPI.Instructions{
+				instructionSet("coding", "local://a", "rule one"),
+			},
+			wantShadowed: 1,
+		},
+		{
+			name:   "WhenDuplicateRuleAndErrorPolicy_ThenKeepsFirstRule",
+			config: &conflictAPI.Config{Policy: conflictAPI.PolicyError},
+			instructions: []instructionAPI.Instructions{
+				instructionSet("coding", "local://a", "rule one", "rule two"),
+				instructionSet("coding", "local://b", "rule one"),
+			},
+			want: []instructionAPI.Instructions{
+				instructionSet("coding", "local://a", "rule one", "rule two"),
+			},
+			wantShadowed: 1,
+		},
+		{
+			name:   "WhenDuplicateRuleAndLastWins_ThenDropsEarlierRule",
+			config: &conflictAPI.Config{Policy: conflictAPI.PolicyLastWins},
+			instructions: []instructionAPI.Instructions{
+				instructionSet("coding", "local://a", "rule one"),
+				instructionSet("coding", "local://b", "rule one", "rule two"),
+			},
+			want: []instructionAPI.Instructions{
+				instructionSet("coding", "local://b", "rule one", "rule two"),
+			},
+			wantShadowed: 1,
+		},
+		{
+			name:   "WhenSameRuleInDifferentCategories_ThenNoConflict",
+			config: nil,
+			instructions: []instructionAPI.Instructions{
+				instructionSet("coding", "local://a", "rule one"),
+				instructionSet("testing", "local://b", "rule one"),
+			},
+			want: []instructionAPI.Instructions{
+				instructionSet("coding", "local://a", "rule one"),
+				instructionSet("testing", "local://b", "rule one"),
+			},
+		},
+		{
+			name: "WhenCategoryOverride_ThenKeepsOnlyOverridingSource",
+			config: &conflictAPI.Config{
+				Overrides: []conflictAPI.Override{
+					{Kind: conflictAPI.KindInstruction, Name: "coding", SourceURI: "local://b"},
+				},
+			},
+			instructions: []instructionAPI.Instructions{
+				instructionSet("coding", "local://a", "rule one"),
+				instructionSet("testing", "local://a", "rule three"),
+				instructionSet("coding", "local://b", "rule two"),
+			},
+			want: []instructionAPI.Instructions{
+				instructionSet("testing", "local://a", "rule three"),
+				instructionSet("coding", "local://b", "rule two"),
+			},
+			wantShadowed: 1,
+		},
+		{
+			name: "WhenCategoryOverrideSourceMissing_ThenReturnsErrOverrideSourceNotFound",
+			config: &conflictAPI.Config{
+				Overrides: []conflictAPI.Override{
+					{Kind: conflictAPI.KindInstruction, Name: "coding", SourceURI: "local://missing"},
+				},
+			},
+			instructions: []instructionAPI.Instructions{
+				instructionSet("coding", "local://a", "rule one"),
+			},
+			wantErr: conflictAPI.ErrOverrideSourceNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resolver := NewResolver(tt.config)
+
+			result, err := ResolveInstructions(resolver, tt.instructions)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				if tt.errorMsg != "" {
+					assert.Contains(t, err.Error(), tt.errorMsg)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+			assert.Len(t, resolver.Shadowed(), tt.wantShadowed)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package conflict
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	conflictAPI "github.com/orbiqd/orbiqd-projectkit/pkg/conflict"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+)
+
+// Resolver applies the configured conflict policy and overrides, and collects every artifact it shadows.
+type Resolver struct {
+	config   conflictAPI.Config
+	shadowed []conflictAPI.Shadow
+}
+
+func NewResolver(config *conflictAPI.Config) *Resolver {
+	resolver := &Resolver{}
+
+	if config != nil {
+		resolver.config = *config
+	}
+
+	if resolver.config.Policy == "" {
+		resolver.config.Policy = conflictAPI.DefaultPolicy
+	}
+
+	return resolver
+}
+
+// Shadowed returns the artifacts dropped by all resolutions so far, in resolution order.
+func (resolver *Resolver) Shadowed() []conflictAPI.Shadow {
+	return resolver.shadowed
+}
+
+// Identify returns the conflict key and provenance of an artifact.
+type Identify[T any] func(item T) (string, *sourceAPI.Provenance)
+
+// Resolve keeps a single artifact per key. Overrides take precedence over the policy; winners keep their
+// original relative order. Artifacts that only differ in provenance are not a conflict, so the error policy keeps
+// the first of them.
+func Resolve[T any](resolver *Resolver, kind conflictAPI.Kind, items []T, identify Identify[T]) ([]T, error) {
+	var keys []string
+	candidates := make(map[string][]int)
+
+	for index, item := range items {
+		key, _ := identify(item)
+		if _, found := candidates[key]; !found {
+			keys = append(keys, key)
+		}
+		candidates[key] = append(candidates[key], index)
+	}
+
+	var winners []int
+	for _, key := range keys {
+		indexes := candidates[key]
+
+		provenances := make([]sourceAPI.Provenance, 0, len(indexes))
+		for _, index := range indexes {
+			provenances = append(provenances, provenanceOf(items[index], identify))
+		}
+
+		position, err := resolver.pickWinner(kind, key, provenances, identicalArtifacts(items, indexes))
+		if err != nil {
+			return nil, err
+		}
+
+		for candidate := range indexes {
+			if candidate == position {
+				continue
+			}
+
+			resolver.shadowed = append(resolver.shadowed, conflictAPI.Shadow{
+				Kind:     kind,
+				Name:     key,
+				Winner:   provenances[position],
+				Shadowed: provenances[candidate],
+			})
+		}
+
+		winners = append(winners, indexes[position])
+	}
+
+	sort.Ints(winners)
+
+	result := make([]T, 0, len(winners))
+	for _, index := range winners {
+		result = append(result, items[index])
+	}
+
+	return result, nil
+}
+
+// pickWinner returns the position of the winning candidate among the provenances of one key.
+func (resolver *Resolver) pickWinner(kind conflictAPI.Kind, key string, provenances []sourceAPI.Provenance, identical bool) (int, error) {
+	if override, found := resolver.config.FindOverride(kind, key); found {
+		for position, provenance := range provenances {
+			if provenance.SourceURI == override.SourceURI {
+				return position, nil
+			}
+		}
+
+		return 0, fmt.Errorf("%s %s from %s: %w", kind, key, override.SourceURI, conflictAPI.ErrOverrideSourceNotFound)
+	}
+
+	if len(provenances) == 1 {
+		return 0, nil
+	}
+
+	policy := resolver.config.Policy
+
+	switch {
+	case policy == conflictAPI.PolicyFirstWins || identical && policy == conflictAPI.PolicyError:
+		return 0, nil
+	case policy == conflictAPI.PolicyLastWins:
+		return len(provenances) - 1, nil
+	default:
+		return 0, fmt.Errorf("%s %s provided by %s and %s: %w", kind, key, provenances[0], provenances[1], conflictAPI.ErrConflict)
+	}
+}
+
+func provenanceOf[T any](item T, identify Identify[T]) sourceAPI.Provenance {
+	_, provenance := identify(item)
+	if provenance == nil {
+		return sourceAPI.Provenance{}
+	}
+
+	return *provenance
+}
+
+// identicalArtifacts reports whether the artifacts at the given indexes only differ in provenance. Artifacts are compared
+// by their JSON form, which every artifact type keeps its provenance in under the "provenance" key.
+func identicalArtifacts[T any](items []T, indexes []int) bool {
+	var first []byte
+	for position, index := range indexes {
+		content, err := contentOf(items[index])
+		if err != nil {
+			return false
+		}
+
+		if position == 0 {
+			first = content
+			continue
+		}
+		if !bytes.Equal(first, content) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func contentOf(item any) ([]byte, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return data, nil
+	}
+	delete(fields, "provenance")
+
+	return json.Marshal(fields)
+}
@@ -0,0 +1,208 @@
+package conflict
+
+import (
+	"testing"
+
+	conflictAPI "github.com/orbiqd/orbiqd-projectkit/pkg/conflict"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type artifact struct {
+	Name       string                `json:"name"`
+	Version    string                `json:"version,omitempty"`
+	Provenance *sourceAPI.Provenance `json:"provenance,omitempty"`
+}
+
+func identifyArtifact(item artifact) (string, *sourceAPI.Provenance) {
+	return item.Name, item.Provenance
+}
+
+func from(uri string) *sourceAPI.Provenance {
+	return &sourceAPI.Provenance{SourceURI: uri}
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	items := []artifact{
+		{Name: "git-commit", Version: "1.0.0", Provenance: from("local://a")},
+		{Name: "review", Version: "1.0.0", Provenance: from("local://a")},
+		{Name: "git-commit", Version: "2.0.0", Provenance: from("local://b")},
+		{Name: "git-commit", Version: "3.0.0", Provenance: from("local://c")},
+	}
+
+	tests := []struct {
+		name         string
+		config       *conflictAPI.Config
+		items        []artifact
+		wantItems    []artifact
+		wantShadowed []conflictAPI.Shadow
+		wantErr      error
+		errorMsg     string
+	}{
+		{
+			name:      "WhenNoDuplicates_ThenKeepsAllInOrder",
+			config:    nil,
+			items:     []artifact{items[0], items[1]},
+			wantItems: []artifact{items[0], items[1]},
+		},
+		{
+			name:     "WhenDefaultPolicyAndDuplicate_ThenReturnsErrConflict",
+			config:   nil,
+			items:    items,
+			wantErr:  conflictAPI.ErrConflict,
+			errorMsg: "skill git-commit provided by local://a and local://b",
+		},
+		{
+			name:      "WhenFirstWins_ThenKeepsFirstAndReportsShadowed",
+			config:    &conflictAPI.Config{Policy: conflictAPI.PolicyFirstWins},
+			items:     items,
+			wantItems: []artifact{items[0], items[1]},
+			wantShadowed: []conflictAPI.Shadow{
+				{Kind: conflictAPI.KindSkill, Name: "git-commit", Winner: *from("local://a"), Shadowed: *from("local://b")},
+				{Kind: conflictAPI.KindSkill, Name: "git-commit", Winner: *from("local://a"), Shadowed: *from("local://c")},
+			},
+		},
+		{
+			name:      "WhenLastWins_ThenKeepsLastInItsPosition",
+			config:    &conflictAPI.Config{Policy: conflictAPI.PolicyLastWins},
+			items:     items,
+			wantItems: []artifact{items[1], items[3]},
+			wantShadowed: []conflictAPI.Shadow{
+				{Kind: conflictAPI.KindSkill, Name: "git-commit", Winner: *from("local://c"), Shadowed: *from("local://a")},
+				{Kind: conflictAPI.KindSkill, Name: "git-commit", Winner: *from("local://c"), Shadowed: *from("local://b")},
+			},
+		},
+		{
+			name: "WhenOverride_ThenOverrideBeatsPolicy",
+			config: &conflictAPI.Config{
+				Policy: conflictAPI.PolicyError,
+				Overrides: []conflictAPI.Override{
+					{Kind: conflictAPI.KindSkill, Name: "git-commit", SourceURI: "local://b"},
+				},
+			},
+			items:     items,
+			wantItems: []artifact{items[1], items[2]},
+			wantShadowed: []conflictAPI.Shadow{
+				{Kind: conflictAPI.KindSkill, Name: "git-commit", Winner: *from("local://b"), Shadowed: *from("local://a")},
+				{Kind: conflictAPI.KindSkill, Name: "git-commit", Winner: *from("local://b"), Shadowed: *from("local://c")},
+			},
+		},
+		{
+			name: "WhenOverrideForOtherKind_ThenPolicyApplies",
+			config: &conflictAPI.Config{
+				Policy: conflictAPI.PolicyError,
+				Overrides: []conflictAPI.Override{
+					{Kind: conflictAPI.KindWorkflow, Name: "git-commit", SourceURI: "local://b"},
+				},
+			},
+			items:   items,
+			wantErr: conflictAPI.ErrConflict,
+		},
+		{
+			name: "WhenOverrideSourceMissing_ThenReturnsErrOverrideSourceNotFound",
+			config: &conflictAPI.Config{
+				Overrides: []conflictAPI.Override{
+					{Kind: conflictAPI.KindSkill, Name: "git-commit", SourceURI: "local://missing"},
+				},
+			},
+			items:    items,
+			wantErr:  conflictAPI.ErrOverrideSourceNotFound,
+			errorMsg: "skill git-commit from local://missing",
+		},
+		{
+			name:   "WhenDefaultPolicyAndDuplicatesOnlyDifferInProvenance_ThenKeepsFirst",
+			config: nil,
+			items: []artifact{
+				{Name: "git-commit", Version: "1.0.0", Provenance: from("local://a")},
+				{Name: "git-commit", Version: "1.0.0", Provenance: from("local://b")},
+			},
+			wantItems: []artifact{{Name: "git-commit", Version: "1.0.0", Provenance: from("local://a")}},
+			wantShadowed: []conflictAPI.Shadow{
+				{Kind: conflictAPI.KindSkill, Name: "git-commit", Winner: *from("local://a"), Shadowed: *from("local://b")},
+			},
+		},
+		{
+			name:   "WhenProvenanceMissing_ThenReportsUnknownSource",
+			config: nil,
+			items: []artifact{
+				{Name: "projectkit", Version: "1.0.0"},
+				{Name: "projectkit", Version: "2.0.0", Provenance: from("local://mcp")},
+			},
+			wantErr:  conflictAPI.ErrConflict,
+			errorMsg: "provided by unknown source and local://mcp",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resolver := NewResolver(tt.config)
+
+			result, err := Resolve(resolver, conflictAPI.KindSkill, tt.items, identifyArtifact)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				if tt.errorMsg != "" {
+					assert.Contains(t, err.Error(), tt.errorMsg)
+				}
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantItems, result)
+			assert.Equal(t, tt.wantShadowed, resolver.Shadowed())
+		})
+	}
+}
+
+func TestResolve_WhenNoPolicyConfigured_ThenAppliesDefaultPolicyToEveryKind(t *testing.T) {
+	t.Parallel()
+
+	duplicates := []artifact{
+		{Name: "x", Version: "1.0.0", Provenance: from("local://a")},
+		{Name: "x", Version: "2.0.0", Provenance: from("local://b")},
+	}
+
+	kinds := []conflictAPI.Kind{
+		conflictAPI.KindSkill,
+		conflictAPI.KindWorkflow,
+		conflictAPI.KindTool,
+		conflictAPI.KindMCPServer,
+		conflictAPI.KindStandard,
+	}
+
+	for _, kind := range kinds {
+		t.Run(string(kind), func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Resolve(NewResolver(nil), kind, duplicates, identifyArtifact)
+
+			require.ErrorIs(t, err, conflictAPI.ErrConflict)
+		})
+	}
+}
+
+func TestResolve_WhenCalledForSeveralKinds_ThenAccumulatesShadowed(t *testing.T) {
+	t.Parallel()
+
+	resolver := NewResolver(&conflictAPI.Config{Policy: conflictAPI.PolicyFirstWins})
+	duplicates := []artifact{
+		{Name: "x", Provenance: from("local://a")},
+		{Name: "x", Provenance: from("local://b")},
+	}
+
+	_, err := Resolve(resolver, conflictAPI.KindSkill, duplicates, identifyArtifact)
+	require.NoError(t, err)
+	_, err = Resolve(resolver, conflictAPI.KindStandard, duplicates, identifyArtifact)
+	require.NoError(t, err)
+
+	shadowed := resolver.Shadowed()
+	require.Len(t, shadowed, 2)
+	assert.Equal(t, conflictAPI.KindSkill, shadowed[0].Kind)
+	assert.Equal(t, conflictAPI.KindStandard, shadowed[1].Kind)
+}
@@ -14,7 +14,9 @@ import (
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+	"github.com/orbiqd/orbiqd-projectkit/pkg/conflict"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/doc"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/doc/standard"
 )
@@ -164,10 +166,12 @@ func (loader *ConfigLoader) merge(configs ...projectAPI.Config) projectAPI.Confi
 			Instruction: &instruction.Config{},
 			Skill:       &skill.Config{},
 			Workflows:   &workflow.Config{},
+			Tool:        &tool.Config{},
 		},
 		Docs: &doc.Config{
 			Standard: &standard.Config{},
 		},
+		Conflict: &conflict.Config{},
 	}
 
 	for _, cfg := range configs {
@@ -186,6 +190,13 @@ func (loader *ConfigLoader) merge(configs ...projectAPI.Config) projectAPI.Confi
 			}
 			if cfg.AI.Workflows != nil {
 				result.AI.Workflows.Sources = append(result.AI.Workflows.Sources, cfg.AI.Workflows.Sources...)
+				result.AI.Workflows.Render = append(result.AI.Workflows.Render, cfg.AI.Workflows.Render...)
+				if cfg.AI.Workflows.Retention != nil {
+					result.AI.Workflows.Retention = cfg.AI.Workflows.Retention
+				}
+			}
+			if cfg.AI.Tool != nil {
+				result.AI.Tool.Sources = append(result.AI.Tool.Sources, cfg.AI.Tool.Sources...)
 			}
 		}
 
@@ -195,6 +206,13 @@ func (loader *ConfigLoader) merge(configs ...projectAPI.Config) projectAPI.Confi
 				result.Docs.Standard.Render = append(result.Docs.Standard.Render, cfg.Docs.Standard.Render...)
 			}
 		}
+
+		if cfg.Conflict != nil {
+			if cfg.Conflict.Policy != "" {
+				result.Conflict.Policy = cfg.Conflict.Policy
+			}
+			result.Conflict.Overrides = append(result.Conflict.Overrides, cfg.Conflict.Overrides...)
+		}
 	}
 
 	return result
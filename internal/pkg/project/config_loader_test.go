@@ -9,11 +9,14 @@ import (
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
+	conflictAPI "github.com/orbiqd/orbiqd-projectkit/pkg/conflict"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/doc"
 	standardAPI "github.com/orbiqd/orbiqd-projectkit/pkg/doc/standard"
 	projectAPI "github.com/orbiqd/orbiqd-projectkit/pkg/project"
 	rulebookAPI "github.com/orbiqd/orbiqd-projectkit/pkg/rulebook"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -228,6 +231,66 @@ func TestConfigLoader_validate(t *testing.T) {
 			},
 			wantErr: projectAPI.ErrConfigValidationFailed,
 		},
+		{
+			name: "WhenValidConflictConfig_ThenReturnsNil",
+			config: projectAPI.Config{
+				Conflict: &conflictAPI.Config{
+					Policy: conflictAPI.PolicyLastWins,
+					Overrides: []conflictAPI.Override{
+						{Kind: conflictAPI.KindSkill, Name: "git-commit", SourceURI: "local://./skills"},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "WhenUnknownConflictPolicy_ThenReturnsValidationError",
+			config: projectAPI.Config{
+				Conflict: &conflictAPI.Config{Policy: "random-wins"},
+			},
+			wantErr: projectAPI.ErrConfigValidationFailed,
+		},
+		{
+			name: "WhenOverrideWithUnknownKind_ThenReturnsValidationError",
+			config: projectAPI.Config{
+				Conflict: &conflictAPI.Config{
+					Overrides: []conflictAPI.Override{
+						{Kind: "design-doc", Name: "x", SourceURI: "local://./docs"},
+					},
+				},
+			},
+			wantErr: projectAPI.ErrConfigValidationFailed,
+		},
+		{
+			name: "WhenSourceFilterValid_ThenReturnsNil",
+			config: projectAPI.Config{
+				Rulebook: &rulebookAPI.Config{
+					Sources: []rulebookAPI.SourceConfig{
+						{
+							URI: "local://./rulebooks/general",
+							Filter: sourceAPI.Filter{
+								Include: []sourceAPI.Selector{{Category: "coding"}, {Tag: "golang"}},
+								Exclude: []sourceAPI.Selector{{Name: "git-commit"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "WhenSourceFilterSelectorEmpty_ThenReturnsValidationError",
+			config: projectAPI.Config{
+				Rulebook: &rulebookAPI.Config{
+					Sources: []rulebookAPI.SourceConfig{
+						{
+							URI:    "local://./rulebooks/general",
+							Filter: sourceAPI.Filter{Exclude: []sourceAPI.Selector{{}}},
+						},
+					},
+				},
+			},
+			wantErr: projectAPI.ErrConfigValidationFailed,
+		},
 	}
 
 	for _, tt := range tests {
@@ -896,3 +959,131 @@ func TestConfigLoader_merge_WithDocsStandard(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigLoader_merge_WithTools(t *testing.T) {
+	t.Parallel()
+
+	configs := []projectAPI.Config{
+		{AI: &ai.Config{Tool: &toolAPI.Config{Sources: []toolAPI.SourceConfig{{URI: "file://A"}}}}},
+		{},
+		{AI: &ai.Config{Tool: &toolAPI.Config{Sources: []toolAPI.SourceConfig{{URI: "file://B"}}}}},
+	}
+
+	loader := NewConfigLoader()
+	result := loader.merge(configs...)
+
+	require.NotNil(t, result.AI.Tool)
+	assert.Equal(t, []toolAPI.SourceConfig{{URI: "file://A"}, {URI: "file://B"}}, result.AI.Tool.Sources)
+}
+
+func TestConfigLoader_merge_WithWorkflowRender(t *testing.T) {
+	t.Parallel()
+
+	configs := []projectAPI.Config{
+		{AI: &ai.Config{Workflows: &workflow.Config{
+			Sources: []workflow.SourceConfig{{URI: "file://A"}},
+			Render:  []workflow.RenderConfig{{Destination: "./docs/workflows", Format: "markdown"}},
+		}}},
+		{},
+		{AI: &ai.Config{Workflows: &workflow.Config{
+			Render: []workflow.RenderConfig{{Destination: "./site/workflows", Format: "markdown"}},
+		}}},
+	}
+
+	loader := NewConfigLoader()
+	result := loader.merge(configs...)
+
+	require.NotNil(t, result.AI.Workflows)
+	assert.Equal(t, []workflow.RenderConfig{
+		{Destination: "./docs/workflows", Format: "markdown"},
+		{Destination: "./site/workflows", Format: "markdown"},
+	}, result.AI.Workflows.Render)
+}
+
+func TestConfigLoader_merge_WithConflict(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		configs       []projectAPI.Config
+		wantPolicy    conflictAPI.Policy
+		wantOverrides []conflictAPI.Override
+	}{
+		{
+			name:       "WhenNoConflictConfig_ThenReturnsEmptyConfig",
+			configs:    []projectAPI.Config{{}},
+			wantPolicy: "",
+		},
+		{
+			name: "WhenTwoConfigsSetPolicy_ThenLaterPolicyWins",
+			configs: []projectAPI.Config{
+				{Conflict: &conflictAPI.Config{Policy: conflictAPI.PolicyFirstWins}},
+				{Conflict: &conflictAPI.Config{Policy: conflictAPI.PolicyLastWins}},
+			},
+			wantPolicy: conflictAPI.PolicyLastWins,
+		},
+		{
+			name: "WhenLaterConfigOmitsPolicy_ThenKeepsEarlierPolicyAndMergesOverrides",
+			configs: []projectAPI.Config{
+				{Conflict: &conflictAPI.Config{
+					Policy:    conflictAPI.PolicyFirstWins,
+					Overrides: []conflictAPI.Override{{Kind: conflictAPI.KindSkill, Name: "a", SourceURI: "file://A"}},
+				}},
+				{Conflict: &conflictAPI.Config{
+					Overrides: []conflictAPI.Override{{Kind: conflictAPI.KindWorkflow, Name: "b", SourceURI: "file://B"}},
+				}},
+			},
+			wantPolicy: conflictAPI.PolicyFirstWins,
+			wantOverrides: []conflictAPI.Override{
+				{Kind: conflictAPI.KindSkill, Name: "a", SourceURI: "file://A"},
+				{Kind: conflictAPI.KindWorkflow, Name: "b", SourceURI: "file://B"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			loader := NewConfigLoader()
+			result := loader.merge(tt.configs...)
+
+			require.NotNil(t, result.Conflict)
+			assert.Equal(t, tt.wantPolicy, result.Conflict.Policy)
+			assert.Equal(t, tt.wantOverrides, result.Conflict.Overrides)
+		})
+	}
+}
+
+func TestConfigLoader_Load_WhenWorkflowRetentionConfigured_ThenLaterRetentionWins(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, filepath.Join("/home/user", ConfigFileName), []byte(`
+ai:
+  workflow:
+    retention:
+      maxCount: 10
+`), 0644))
+	require.NoError(t, afero.WriteFile(fs, filepath.Join("/project", ConfigFileName), []byte(`
+ai:
+  workflow:
+    sources:
+      - uri: "file://workflows"
+    retention:
+      maxAge: "720h"
+      maxCount: 5
+`), 0644))
+
+	loader := NewConfigLoader(
+		WithConfigLoaderFs(fs),
+		WithConfigLoaderGetHomeDirFn(func() (string, error) { return "/home/user", nil }),
+		WithConfigLoaderGetWorkDirFn(func() (string, error) { return "/project", nil }),
+	)
+
+	config, err := loader.Load()
+
+	require.NoError(t, err)
+	require.NotNil(t, config.AI.Workflows)
+	assert.Equal(t, &workflow.RetentionConfig{MaxAge: "720h", MaxCount: 5}, config.AI.Workflows.Retention)
+}
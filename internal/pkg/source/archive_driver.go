@@ -0,0 +1,215 @@
+package source
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+	"github.com/spf13/afero"
+)
+
+type ArchiveDriverOpt func(*ArchiveDriver)
+
+// WithArchiveRootFs overrides the filesystem archive+file:// uris are read from.
+func WithArchiveRootFs(fs afero.Fs) ArchiveDriverOpt {
+	return func(driver *ArchiveDriver) {
+		driver.rootFs = fs
+	}
+}
+
+// WithArchiveHTTPClient overrides the client used to download archive+https:// uris.
+func WithArchiveHTTPClient(client *http.Client) ArchiveDriverOpt {
+	return func(driver *ArchiveDriver) {
+		driver.httpClient = client
+	}
+}
+
+// WithArchiveCache makes the driver unpack archives into the cache instead of memory. Downloaded
+// archives are then served from the cache in offline mode.
+func WithArchiveCache(cache *Cache) ArchiveDriverOpt {
+	return func(driver *ArchiveDriver) {
+		driver.cache = cache
+	}
+}
+
+// ArchiveDriver resolves archive+file:// and archive+https:// uris pointing at .tar.gz, .tgz or .zip
+// files. Archives are unpacked into memory, or into the cache when one is configured, and exposed as
+// a read-only filesystem.
+type ArchiveDriver struct {
+	rootFs     afero.Fs
+	httpClient *http.Client
+	cache      *Cache
+}
+
+var _ sourceAPI.Driver = (*ArchiveDriver)(nil)
+
+func NewArchiveDriver(opts ...ArchiveDriverOpt) *ArchiveDriver {
+	driver := &ArchiveDriver{
+		rootFs:     afero.NewOsFs(),
+		httpClient: http.DefaultClient,
+	}
+
+	for _, opt := range opts {
+		opt(driver)
+	}
+
+	return driver
+}
+
+func (driver *ArchiveDriver) GetSupportedSchemes() []string {
+	return []string{"archive+file", "archive+https"}
+}
+
+func (driver *ArchiveDriver) Resolve(uri string) (afero.Fs, error) {
+	remote, err := parseRemoteUri(uri, driver.GetSupportedSchemes(), "archive")
+	if err != nil {
+		return nil, err
+	}
+
+	if remote.ref != "" {
+		return nil, fmt.Errorf("ref is not supported in archive uri %s", uri)
+	}
+
+	location, err := url.Parse(remote.location)
+	if err != nil {
+		return nil, fmt.Errorf("archive location %s parse: %w", remote.location, err)
+	}
+
+	if driver.cache != nil {
+		return driver.resolveCached(remote, location)
+	}
+
+	content, err := driver.download(location)
+	if err != nil {
+		return nil, err
+	}
+
+	archiveFs := afero.NewMemMapFs()
+	if err := unpackArchive(location.Path, content, afero.NewBasePathFs(archiveFs, "/")); err != nil {
+		return nil, fmt.Errorf("unpack %s: %w", remote.location, err)
+	}
+
+	contentDir := path.Join("/", remote.subdir)
+	exists, err := afero.DirExists(archiveFs, contentDir)
+	if err != nil {
+		return nil, fmt.Errorf("checking subdir %s: %w", remote.subdir, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("subdir %s does not exist in %s", remote.subdir, remote.location)
+	}
+
+	scopedFs := afero.NewBasePathFs(archiveFs, contentDir)
+	readOnlyFs := afero.NewReadOnlyFs(scopedFs)
+
+	return sourceAPI.NewRevisionedFs(readOnlyFs, archiveDigest(content)), nil
+}
+
+func (driver *ArchiveDriver) resolveCached(remote remoteUri, location *url.URL) (afero.Fs, error) {
+	var revision sourceAPI.Revision
+
+	if driver.cache.IsOffline() && location.Scheme != "file" {
+		cachedRevision, err := driver.cache.LookupRevision(remote.location)
+		if err != nil {
+			return nil, err
+		}
+
+		exists, err := afero.DirExists(driver.cache.osFs, driver.entryPath(remote.location, cachedRevision))
+		if err != nil {
+			return nil, fmt.Errorf("checking archive cache: %w", err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("archive %s: %w", remote.location, sourceAPI.ErrSourceNotCached)
+		}
+
+		revision = cachedRevision
+	} else {
+		content, err := driver.download(location)
+		if err != nil {
+			return nil, err
+		}
+
+		revision = archiveDigest(content)
+		err = driver.cache.Populate(driver.entryPath(remote.location, revision), func(fs afero.Fs) error {
+			return unpackArchive(location.Path, content, fs)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unpack %s: %w", remote.location, err)
+		}
+
+		if err := driver.cache.StoreRevision(remote.location, revision); err != nil {
+			return nil, err
+		}
+	}
+
+	fs, err := driver.cache.Open(driver.entryPath(remote.location, revision), remote.subdir)
+	if err != nil {
+		return nil, fmt.Errorf("archive %s: %w", remote.location, err)
+	}
+
+	return sourceAPI.NewRevisionedFs(fs, revision), nil
+}
+
+func (driver *ArchiveDriver) entryPath(location string, revision sourceAPI.Revision) string {
+	return driver.cache.Path("archive", cacheKey(location), strings.TrimPrefix(string(revision), contentHashPrefix))
+}
+
+func (driver *ArchiveDriver) download(location *url.URL) ([]byte, error) {
+	switch location.Scheme {
+	case "file":
+		filePath := location.Host + location.Path
+		content, err := afero.ReadFile(driver.rootFs, filePath)
+		if err != nil {
+			return nil, fmt.Errorf("archive %s read: %w", filePath, err)
+		}
+
+		return content, nil
+	case "https":
+		response, err := driver.httpClient.Get(location.String())
+		if err != nil {
+			return nil, fmt.Errorf("archive %s download: %w", location, err)
+		}
+		defer func() { _ = response.Body.Close() }()
+
+		if response.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("archive %s download: unexpected status %s", location, response.Status)
+		}
+
+		var buffer bytes.Buffer
+		if _, err := io.Copy(&buffer, response.Body); err != nil {
+			return nil, fmt.Errorf("archive %s download: %w", location, err)
+		}
+
+		return buffer.Bytes(), nil
+	}
+
+	return nil, fmt.Errorf("archive location %s: %w", location, sourceAPI.ErrUnsupportedScheme)
+}
+
+func archiveDigest(content []byte) sourceAPI.Revision {
+	sum := sha256.Sum256(content)
+	return sourceAPI.Revision(contentHashPrefix + hex.EncodeToString(sum[:]))
+}
+
+func unpackArchive(archivePath string, content []byte, fs afero.Fs) error {
+	name := strings.ToLower(archivePath)
+
+	switch {
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		return extractTarGz(bytes.NewReader(content), fs)
+	case strings.HasSuffix(name, ".zip"):
+		return extractZip(content, fs)
+	}
+
+	return fmt.Errorf("archive %s: %w", archivePath, ErrUnsupportedArchiveFormat)
+}
+
+// ErrUnsupportedArchiveFormat is returned when an archive uri does not end with .tar.gz, .tgz or .zip.
+var ErrUnsupportedArchiveFormat = errors.New("unsupported archive format")
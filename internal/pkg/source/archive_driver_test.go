@@ -0,0 +1,315 @@
+package source
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var archiveEntries = []tarEntry{
+	{name: "rulebooks-1.2.0/", typeflag: tar.TypeDir},
+	{name: "rulebooks-1.2.0/golang/rulebook.yaml", typeflag: tar.TypeReg, content: "name: golang"},
+	{name: "rulebooks-1.2.0/general/rulebook.yaml", typeflag: tar.TypeReg, content: "name: general"},
+}
+
+func buildTarGz(t *testing.T, entries []tarEntry) []byte {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	_, err := writer.Write(buildTar(t, entries))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	return buffer.Bytes()
+}
+
+func buildZip(t *testing.T, entries []tarEntry) []byte {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	for _, entry := range entries {
+		file, err := writer.Create(entry.name)
+		require.NoError(t, err)
+		if entry.typeflag == tar.TypeReg {
+			_, err = file.Write([]byte(entry.content))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, writer.Close())
+
+	return buffer.Bytes()
+}
+
+func TestArchiveDriver_Resolve(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		setupFs         func(*testing.T, afero.Fs)
+		uri             string
+		expectedFile    string
+		expectedContent string
+		expectError     bool
+		errorMsg        string
+		errorIs         error
+	}{
+		{
+			name: "WhenTarGzWithSubdir_ThenReturnsScopedFs",
+			setupFs: func(t *testing.T, fs afero.Fs) {
+				require.NoError(t, afero.WriteFile(fs, "/dist/rulebooks.tar.gz", buildTarGz(t, archiveEntries), 0o644))
+			},
+			uri:             "archive+file:///dist/rulebooks.tar.gz#subdir=rulebooks-1.2.0/golang",
+			expectedFile:    "rulebook.yaml",
+			expectedContent: "name: golang",
+		},
+		{
+			name: "WhenTgzWithoutSubdir_ThenReturnsArchiveRoot",
+			setupFs: func(t *testing.T, fs afero.Fs) {
+				require.NoError(t, afero.WriteFile(fs, "/dist/rulebooks.tgz", buildTarGz(t, archiveEntries), 0o644))
+			},
+			uri:             "archive+file:///dist/rulebooks.tgz",
+			expectedFile:    "rulebooks-1.2.0/general/rulebook.yaml",
+			expectedContent: "name: general",
+		},
+		{
+			name: "WhenZip_ThenReturnsFs",
+			setupFs: func(t *testing.T, fs afero.Fs) {
+				require.NoError(t, afero.WriteFile(fs, "/dist/rulebooks.zip", buildZip(t, archiveEntries), 0o644))
+			},
+			uri:             "archive+file:///dist/rulebooks.zip#subdir=rulebooks-1.2.0",
+			expectedFile:    "golang/rulebook.yaml",
+			expectedContent: "name: golang",
+		},
+		{
+			name: "WhenRelativePath_ThenReadsRelativeToRoot",
+			setupFs: func(t *testing.T, fs afero.Fs) {
+				require.NoError(t, afero.WriteFile(fs, "dist/rulebooks.zip", buildZip(t, archiveEntries), 0o644))
+			},
+			uri:             "archive+file://dist/rulebooks.zip#subdir=rulebooks-1.2.0/general",
+			expectedFile:    "rulebook.yaml",
+			expectedContent: "name: general",
+		},
+		{
+			name:        "WhenArchiveNotExists_ThenReturnsError",
+			setupFs:     func(t *testing.T, fs afero.Fs) {},
+			uri:         "archive+file:///dist/missing.tar.gz",
+			expectError: true,
+			errorMsg:    "archive /dist/missing.tar.gz read",
+		},
+		{
+			name: "WhenUnsupportedExtension_ThenReturnsError",
+			setupFs: func(t *testing.T, fs afero.Fs) {
+				require.NoError(t, afero.WriteFile(fs, "/dist/rulebooks.rar", []byte("rar"), 0o644))
+			},
+			uri:         "archive+file:///dist/rulebooks.rar",
+			expectError: true,
+			errorIs:     ErrUnsupportedArchiveFormat,
+		},
+		{
+			name: "WhenArchiveCorrupted_ThenReturnsError",
+			setupFs: func(t *testing.T, fs afero.Fs) {
+				require.NoError(t, afero.WriteFile(fs, "/dist/rulebooks.tar.gz", []byte("corrupted"), 0o644))
+			},
+			uri:         "archive+file:///dist/rulebooks.tar.gz",
+			expectError: true,
+			errorMsg:    "gzip stream open",
+		},
+		{
+			name: "WhenSubdirNotExists_ThenReturnsError",
+			setupFs: func(t *testing.T, fs afero.Fs) {
+				require.NoError(t, afero.WriteFile(fs, "/dist/rulebooks.zip", buildZip(t, archiveEntries), 0o644))
+			},
+			uri:         "archive+file:///dist/rulebooks.zip#subdir=missing",
+			expectError: true,
+			errorMsg:    "subdir missing does not exist",
+		},
+		{
+			name:        "WhenRefGiven_ThenReturnsError",
+			setupFs:     func(t *testing.T, fs afero.Fs) {},
+			uri:         "archive+file:///dist/rulebooks.zip?ref=main",
+			expectError: true,
+			errorMsg:    "ref is not supported",
+		},
+		{
+			name:        "WhenInvalidScheme_ThenReturnsError",
+			setupFs:     func(t *testing.T, fs afero.Fs) {},
+			uri:         "local://dist",
+			expectError: true,
+			errorIs:     sourceAPI.ErrUnsupportedScheme,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			memFs := afero.NewMemMapFs()
+			tt.setupFs(t, memFs)
+
+			driver := NewArchiveDriver(WithArchiveRootFs(memFs))
+
+			result, err := driver.Resolve(tt.uri)
+
+			if tt.expectError {
+				require.Error(t, err)
+				if tt.errorMsg != "" {
+					assert.Contains(t, err.Error(), tt.errorMsg)
+				}
+				if tt.errorIs != nil {
+					assert.ErrorIs(t, err, tt.errorIs)
+				}
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+
+			content, readErr := afero.ReadFile(result, tt.expectedFile)
+			require.NoError(t, readErr)
+			assert.Equal(t, tt.expectedContent, string(content))
+
+			writeErr := afero.WriteFile(result, "test.txt", []byte("content"), 0o644)
+			assert.Error(t, writeErr, "write should fail on read-only filesystem")
+
+			revision, found := sourceAPI.RevisionOf(result)
+			assert.True(t, found)
+			assert.True(t, strings.HasPrefix(string(revision), "sha256:"))
+		})
+	}
+}
+
+func TestArchiveDriver_Resolve_WhenHttps(t *testing.T) {
+	t.Parallel()
+
+	archive := buildTarGz(t, archiveEntries)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch {
+		case request.URL.Path == "/signed/rulebooks.tar.gz" && request.URL.RawQuery != "X-Amz-Expires=300&X-Amz-Signature=abc%2Fdef":
+			http.Error(writer, "signature mismatch", http.StatusForbidden)
+			return
+		case request.URL.Path != "/releases/rulebooks.tar.gz" && request.URL.Path != "/signed/rulebooks.tar.gz":
+			http.NotFound(writer, request)
+			return
+		}
+		_, _ = writer.Write(archive)
+	}))
+	t.Cleanup(server.Close)
+
+	driver := NewArchiveDriver(WithArchiveHTTPClient(server.Client()))
+	baseUri := "archive+" + server.URL
+
+	t.Run("WhenArchiveServed_ThenReturnsFs", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := driver.Resolve(baseUri + "/releases/rulebooks.tar.gz#subdir=rulebooks-1.2.0/golang")
+
+		require.NoError(t, err)
+		content, readErr := afero.ReadFile(result, "rulebook.yaml")
+		require.NoError(t, readErr)
+		assert.Equal(t, "name: golang", string(content))
+	})
+
+	t.Run("WhenUrlSigned_ThenDownloadsWithQuery", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := driver.Resolve(baseUri + "/signed/rulebooks.tar.gz?X-Amz-Expires=300&X-Amz-Signature=abc%2Fdef#subdir=rulebooks-1.2.0/golang")
+
+		require.NoError(t, err)
+		content, readErr := afero.ReadFile(result, "rulebook.yaml")
+		require.NoError(t, readErr)
+		assert.Equal(t, "name: golang", string(content))
+	})
+
+	t.Run("WhenArchiveNotFound_ThenReturnsError", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := driver.Resolve(baseUri + "/releases/missing.tar.gz")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unexpected status 404")
+		assert.Nil(t, result)
+	})
+}
+
+func TestArchiveDriver_GetSupportedSchemes(t *testing.T) {
+	t.Parallel()
+
+	driver := NewArchiveDriver()
+
+	schemes := driver.GetSupportedSchemes()
+
+	assert.Equal(t, []string{"archive+file", "archive+https"}, schemes)
+}
+
+func TestArchiveDriver_Resolve_WhenCached(t *testing.T) {
+	t.Parallel()
+
+	archive := buildTarGz(t, archiveEntries)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write(archive)
+	}))
+	serverUrl := server.URL
+	uri := "archive+" + serverUrl + "/releases/rulebooks.tar.gz#subdir=rulebooks-1.2.0/golang"
+	client := server.Client()
+	cacheDir := t.TempDir()
+
+	t.Run("WhenOfflineAndCacheCold_ThenReturnsErrSourceNotCached", func(t *testing.T) {
+		driver := NewArchiveDriver(
+			WithArchiveHTTPClient(client),
+			WithArchiveCache(NewCache(t.TempDir(), WithOffline(true))),
+		)
+
+		result, err := driver.Resolve(uri)
+
+		require.ErrorIs(t, err, sourceAPI.ErrSourceNotCached)
+		assert.Nil(t, result)
+	})
+
+	onlineDriver := NewArchiveDriver(WithArchiveHTTPClient(client), WithArchiveCache(NewCache(cacheDir)))
+	onlineFs, err := onlineDriver.Resolve(uri)
+	require.NoError(t, err)
+	onlineRevision, _ := sourceAPI.RevisionOf(onlineFs)
+
+	server.Close()
+
+	t.Run("WhenOfflineAndCacheWarm_ThenServesFromCache", func(t *testing.T) {
+		driver := NewArchiveDriver(
+			WithArchiveHTTPClient(client),
+			WithArchiveCache(NewCache(cacheDir, WithOffline(true))),
+		)
+
+		result, err := driver.Resolve(uri)
+
+		require.NoError(t, err)
+		content, readErr := afero.ReadFile(result, "rulebook.yaml")
+		require.NoError(t, readErr)
+		assert.Equal(t, "name: golang", string(content))
+
+		revision, found := sourceAPI.RevisionOf(result)
+		assert.True(t, found)
+		assert.Equal(t, onlineRevision, revision)
+	})
+
+	t.Run("WhenOnlineAndServerDown_ThenReturnsError", func(t *testing.T) {
+		driver := NewArchiveDriver(WithArchiveHTTPClient(client), WithArchiveCache(NewCache(cacheDir)))
+
+		result, err := driver.Resolve(uri)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "download")
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,163 @@
+package source
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+	"github.com/spf13/afero"
+)
+
+type CacheOpt func(*Cache)
+
+// WithOffline makes drivers using the cache resolve remote sources without touching the network.
+func WithOffline(offline bool) CacheOpt {
+	return func(cache *Cache) {
+		cache.offline = offline
+	}
+}
+
+// Cache stores remote sources on disk keyed by uri and revision so that they can be resolved again
+// without network access.
+type Cache struct {
+	osFs    afero.Fs
+	dir     string
+	offline bool
+}
+
+func NewCache(dir string, opts ...CacheOpt) *Cache {
+	cache := &Cache{
+		osFs: afero.NewOsFs(),
+		dir:  dir,
+	}
+
+	for _, opt := range opts {
+		opt(cache)
+	}
+
+	return cache
+}
+
+// NewCacheFromConfig creates a cache in the configured directory, falling back to DefaultCacheDir.
+func NewCacheFromConfig(config Config) (*Cache, error) {
+	dir := config.SourceCacheDir
+	if dir == "" {
+		defaultDir, err := DefaultCacheDir()
+		if err != nil {
+			return nil, err
+		}
+
+		dir = defaultDir
+	}
+
+	return NewCache(dir, WithOffline(config.Offline)), nil
+}
+
+// DefaultCacheDir returns $XDG_CACHE_HOME/projectkit/sources, or the platform user cache directory
+// when XDG_CACHE_HOME is not set.
+func DefaultCacheDir() (string, error) {
+	cacheHome := os.Getenv("XDG_CACHE_HOME")
+	if cacheHome == "" {
+		userCacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return "", fmt.Errorf("user cache dir: %w", err)
+		}
+
+		cacheHome = userCacheDir
+	}
+
+	return filepath.Join(cacheHome, "projectkit", "sources"), nil
+}
+
+// IsOffline reports whether remote sources must be served from the cache only.
+func (cache *Cache) IsOffline() bool {
+	return cache.offline
+}
+
+// Path returns a path inside the cache directory.
+func (cache *Cache) Path(elem ...string) string {
+	return filepath.Join(append([]string{cache.dir}, elem...)...)
+}
+
+// LookupRevision returns the revision the uri resolved to the last time it was fetched.
+// Returns sourceAPI.ErrSourceNotCached if the uri was never fetched.
+func (cache *Cache) LookupRevision(uri string) (sourceAPI.Revision, error) {
+	content, err := afero.ReadFile(cache.osFs, cache.revisionPath(uri))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("uri %s: %w", uri, sourceAPI.ErrSourceNotCached)
+	}
+	if err != nil {
+		return "", fmt.Errorf("cached revision read: %w", err)
+	}
+
+	return sourceAPI.Revision(strings.TrimSpace(string(content))), nil
+}
+
+// StoreRevision records the revision the uri resolved to.
+func (cache *Cache) StoreRevision(uri string, revision sourceAPI.Revision) error {
+	path := cache.revisionPath(uri)
+
+	if err := cache.osFs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("revision directory creation: %w", err)
+	}
+
+	if err := afero.WriteFile(cache.osFs, path, []byte(revision+"\n"), 0644); err != nil {
+		return fmt.Errorf("cached revision write: %w", err)
+	}
+
+	return nil
+}
+
+// Populate fills the directory for the given path once, using a staging directory so that an
+// interrupted fill never leaves a partially populated entry behind.
+func (cache *Cache) Populate(path string, fill func(fs afero.Fs) error) error {
+	exists, err := afero.DirExists(cache.osFs, path)
+	if err != nil {
+		return fmt.Errorf("checking cache entry %s: %w", path, err)
+	}
+	if exists {
+		return nil
+	}
+
+	stagingPath := path + ".partial"
+	if err := cache.osFs.RemoveAll(stagingPath); err != nil {
+		return fmt.Errorf("staging directory cleanup: %w", err)
+	}
+	if err := cache.osFs.MkdirAll(stagingPath, 0755); err != nil {
+		return fmt.Errorf("staging directory creation: %w", err)
+	}
+
+	if err := fill(afero.NewBasePathFs(cache.osFs, stagingPath)); err != nil {
+		_ = cache.osFs.RemoveAll(stagingPath)
+		return err
+	}
+
+	if err := cache.osFs.Rename(stagingPath, path); err != nil {
+		_ = cache.osFs.RemoveAll(stagingPath)
+		return fmt.Errorf("cache entry %s rename: %w", path, err)
+	}
+
+	return nil
+}
+
+// Open returns a read-only filesystem scoped to a subdirectory of a populated cache entry.
+func (cache *Cache) Open(path, subdir string) (afero.Fs, error) {
+	contentPath := filepath.Join(path, filepath.FromSlash(subdir))
+
+	exists, err := afero.DirExists(cache.osFs, contentPath)
+	if err != nil {
+		return nil, fmt.Errorf("checking subdir %s: %w", subdir, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("subdir %s does not exist", subdir)
+	}
+
+	return afero.NewReadOnlyFs(afero.NewBasePathFs(cache.osFs, contentPath)), nil
+}
+
+func (cache *Cache) revisionPath(uri string) string {
+	return cache.Path("revisions", cacheKey(uri))
+}
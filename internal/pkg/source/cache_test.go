@@ -0,0 +1,132 @@
+package source
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultCacheDir_WhenXdgCacheHomeSet_ThenUsesIt(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", "/var/cache/user")
+
+	dir, err := DefaultCacheDir()
+
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join("/var/cache/user", "projectkit", "sources"), dir)
+}
+
+func TestNewCacheFromConfig(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", "/var/cache/user")
+
+	t.Run("WhenCacheDirConfigured_ThenUsesIt", func(t *testing.T) {
+		cache, err := NewCacheFromConfig(Config{SourceCacheDir: "/tmp/projectkit", Offline: true})
+
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join("/tmp/projectkit", "git"), cache.Path("git"))
+		assert.True(t, cache.IsOffline())
+	})
+
+	t.Run("WhenCacheDirEmpty_ThenUsesDefault", func(t *testing.T) {
+		cache, err := NewCacheFromConfig(Config{})
+
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join("/var/cache/user", "projectkit", "sources"), cache.Path())
+		assert.False(t, cache.IsOffline())
+	})
+}
+
+func TestCache_Revision(t *testing.T) {
+	t.Parallel()
+
+	cache := NewCache(t.TempDir())
+
+	_, err := cache.LookupRevision("archive+https://example.com/rules.tgz")
+	require.ErrorIs(t, err, sourceAPI.ErrSourceNotCached)
+
+	require.NoError(t, cache.StoreRevision("archive+https://example.com/rules.tgz", "sha256:abc"))
+
+	revision, err := cache.LookupRevision("archive+https://example.com/rules.tgz")
+	require.NoError(t, err)
+	assert.Equal(t, sourceAPI.Revision("sha256:abc"), revision)
+}
+
+func TestCache_Populate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("WhenFillSucceeds_ThenEntryIsReadable", func(t *testing.T) {
+		t.Parallel()
+
+		cache := NewCache(t.TempDir())
+		entry := cache.Path("archive", "key", "digest")
+
+		err := cache.Populate(entry, func(fs afero.Fs) error {
+			if err := fs.MkdirAll("rules", 0o755); err != nil {
+				return err
+			}
+			return afero.WriteFile(fs, "rules/rulebook.yaml", []byte("name: rules"), 0o644)
+		})
+		require.NoError(t, err)
+
+		fs, err := cache.Open(entry, "rules")
+		require.NoError(t, err)
+		content, err := afero.ReadFile(fs, "rulebook.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, "name: rules", string(content))
+		assert.Error(t, afero.WriteFile(fs, "other.yaml", nil, 0o644), "cache entries should be read-only")
+	})
+
+	t.Run("WhenEntryExists_ThenSkipsFill", func(t *testing.T) {
+		t.Parallel()
+
+		cache := NewCache(t.TempDir())
+		entry := cache.Path("entry")
+		calls := 0
+		fill := func(fs afero.Fs) error {
+			calls++
+			return nil
+		}
+
+		require.NoError(t, cache.Populate(entry, fill))
+		require.NoError(t, cache.Populate(entry, fill))
+
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("WhenFillFails_ThenLeavesNoEntry", func(t *testing.T) {
+		t.Parallel()
+
+		cache := NewCache(t.TempDir())
+		entry := cache.Path("entry")
+
+		err := cache.Populate(entry, func(fs afero.Fs) error {
+			_ = afero.WriteFile(fs, "half.yaml", []byte("half"), 0o644)
+			return errors.New("unpack failed")
+		})
+
+		require.ErrorContains(t, err, "unpack failed")
+		exists, err := afero.Exists(afero.NewOsFs(), entry)
+		require.NoError(t, err)
+		assert.False(t, exists)
+		exists, err = afero.Exists(afero.NewOsFs(), entry+".partial")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestCache_Open_WhenSubdirMissing_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	cache := NewCache(t.TempDir())
+	entry := cache.Path("entry")
+	require.NoError(t, cache.Populate(entry, func(fs afero.Fs) error { return nil }))
+
+	fs, err := cache.Open(entry, "missing")
+
+	require.ErrorContains(t, err, "subdir missing does not exist")
+	assert.Nil(t, fs)
+}
@@ -0,0 +1,6 @@
+package source
+
+type Config struct {
+	SourceCacheDir string `help:"Directory for cached remote sources. Defaults to $XDG_CACHE_HOME/projectkit/sources." type:"path"`
+	Offline        bool   `help:"Resolve remote sources only from the local cache."`
+}
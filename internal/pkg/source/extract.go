@@ -0,0 +1,135 @@
+package source
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+func sanitizeEntryPath(name string) (string, error) {
+	normalized := strings.ReplaceAll(name, "\\", "/")
+	if path.IsAbs(normalized) {
+		return "", fmt.Errorf("entry %s: %w", name, ErrUnsafeEntryPath)
+	}
+
+	cleaned := path.Clean(normalized)
+	if cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("entry %s: %w", name, ErrUnsafeEntryPath)
+	}
+
+	return cleaned, nil
+}
+
+func extractTar(reader io.Reader, fs afero.Fs) error {
+	tarReader := tar.NewReader(reader)
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("tar entry read: %w", err)
+		}
+
+		entryPath, err := sanitizeEntryPath(header.Name)
+		if err != nil {
+			return err
+		}
+		if entryPath == "" || entryPath == "." {
+			continue
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := fs.MkdirAll(entryPath, 0755); err != nil {
+				return fmt.Errorf("directory %s creation: %w", entryPath, err)
+			}
+		case tar.TypeReg:
+			if err := writeEntry(fs, entryPath, tarReader); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func extractTarGz(reader io.Reader, fs afero.Fs) error {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return fmt.Errorf("gzip stream open: %w", err)
+	}
+	defer func() { _ = gzipReader.Close() }()
+
+	return extractTar(gzipReader, fs)
+}
+
+func extractZip(content []byte, fs afero.Fs) error {
+	zipReader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return fmt.Errorf("zip archive open: %w", err)
+	}
+
+	for _, file := range zipReader.File {
+		entryPath, err := sanitizeEntryPath(file.Name)
+		if err != nil {
+			return err
+		}
+		if entryPath == "" || entryPath == "." {
+			continue
+		}
+
+		mode := file.Mode()
+		switch {
+		case mode.IsDir():
+			if err := fs.MkdirAll(entryPath, 0755); err != nil {
+				return fmt.Errorf("directory %s creation: %w", entryPath, err)
+			}
+		case mode.IsRegular():
+			if err := extractZipFile(fs, entryPath, file); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func extractZipFile(fs afero.Fs, entryPath string, file *zip.File) error {
+	content, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("zip entry %s open: %w", entryPath, err)
+	}
+	defer func() { _ = content.Close() }()
+
+	return writeEntry(fs, entryPath, content)
+}
+
+func writeEntry(fs afero.Fs, entryPath string, content io.Reader) error {
+	if err := fs.MkdirAll(path.Dir(entryPath), 0755); err != nil {
+		return fmt.Errorf("directory %s creation: %w", path.Dir(entryPath), err)
+	}
+
+	file, err := fs.OpenFile(entryPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("file %s creation: %w", entryPath, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	if _, err := io.Copy(file, content); err != nil {
+		return fmt.Errorf("file %s write: %w", entryPath, err)
+	}
+
+	return nil
+}
+
+// ErrUnsafeEntryPath is returned when an archive entry points outside of the extraction root.
+var ErrUnsafeEntryPath = errors.New("unsafe entry path")
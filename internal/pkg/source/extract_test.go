@@ -0,0 +1,161 @@
+package source
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tarEntry struct {
+	name     string
+	typeflag byte
+	content  string
+}
+
+func buildTar(t *testing.T, entries []tarEntry) []byte {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	writer := tar.NewWriter(&buffer)
+
+	for _, entry := range entries {
+		header := &tar.Header{
+			Name:     entry.name,
+			Typeflag: entry.typeflag,
+			Mode:     0o644,
+			Size:     int64(len(entry.content)),
+		}
+		if entry.typeflag != tar.TypeReg {
+			header.Size = 0
+		}
+		if entry.typeflag == tar.TypeSymlink {
+			header.Linkname = entry.content
+		}
+
+		require.NoError(t, writer.WriteHeader(header))
+		if entry.typeflag == tar.TypeReg {
+			_, err := writer.Write([]byte(entry.content))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, writer.Close())
+
+	return buffer.Bytes()
+}
+
+func TestExtractTar(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		entries       []tarEntry
+		expectedFiles map[string]string
+		expectError   bool
+		errorIs       error
+	}{
+		{
+			name: "WhenFilesAndDirectories_ThenExtractsAll",
+			entries: []tarEntry{
+				{name: "rules/", typeflag: tar.TypeDir},
+				{name: "rules/rulebook.yaml", typeflag: tar.TypeReg, content: "name: rules"},
+				{name: "nested/deep/file.txt", typeflag: tar.TypeReg, content: "deep"},
+			},
+			expectedFiles: map[string]string{
+				"rules/rulebook.yaml":  "name: rules",
+				"nested/deep/file.txt": "deep",
+			},
+		},
+		{
+			name: "WhenSymlinkEntry_ThenSkipsIt",
+			entries: []tarEntry{
+				{name: "link", typeflag: tar.TypeSymlink, content: "/etc/passwd"},
+				{name: "file.txt", typeflag: tar.TypeReg, content: "content"},
+			},
+			expectedFiles: map[string]string{
+				"file.txt": "content",
+			},
+		},
+		{
+			name: "WhenEntryEscapesRoot_ThenReturnsError",
+			entries: []tarEntry{
+				{name: "../outside.txt", typeflag: tar.TypeReg, content: "evil"},
+			},
+			expectError: true,
+			errorIs:     ErrUnsafeEntryPath,
+		},
+		{
+			name: "WhenEntryAbsolute_ThenReturnsError",
+			entries: []tarEntry{
+				{name: "/etc/passwd", typeflag: tar.TypeReg, content: "evil"},
+			},
+			expectError: true,
+			errorIs:     ErrUnsafeEntryPath,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fs := afero.NewMemMapFs()
+
+			err := extractTar(bytes.NewReader(buildTar(t, tt.entries)), fs)
+
+			if tt.expectError {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.errorIs)
+				return
+			}
+
+			require.NoError(t, err)
+			for path, expected := range tt.expectedFiles {
+				content, readErr := afero.ReadFile(fs, path)
+				require.NoError(t, readErr)
+				assert.Equal(t, expected, string(content))
+			}
+
+			exists, _ := afero.Exists(fs, "link")
+			assert.False(t, exists)
+		})
+	}
+}
+
+func TestExtractTar_WhenArchiveCorrupted_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	err := extractTar(bytes.NewReader([]byte("not a tar archive at all, definitely")), afero.NewMemMapFs())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "tar entry read")
+}
+
+func TestExtractZip_WhenEntryEscapesRoot_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	file, err := writer.Create("../outside.txt")
+	require.NoError(t, err)
+	_, err = file.Write([]byte("evil"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	err = extractZip(buffer.Bytes(), afero.NewMemMapFs())
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrUnsafeEntryPath)
+}
+
+func TestExtractZip_WhenArchiveCorrupted_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	err := extractZip([]byte("corrupted"), afero.NewMemMapFs())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "zip archive open")
+}
@@ -0,0 +1,206 @@
+package source
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+	"github.com/spf13/afero"
+)
+
+type GitDriverOpt func(*GitDriver)
+
+// WithGitCache overrides the cache in which repositories and checkouts are stored.
+func WithGitCache(cache *Cache) GitDriverOpt {
+	return func(driver *GitDriver) {
+		driver.cache = cache
+	}
+}
+
+// WithGitBinary overrides the git executable used by the driver.
+func WithGitBinary(binary string) GitDriverOpt {
+	return func(driver *GitDriver) {
+		driver.gitBinary = binary
+	}
+}
+
+// GitDriver resolves git://, git+ssh:// and git+file:// uris. Repositories are mirrored into the
+// cache and every resolved commit is checked out once into its own directory. In offline mode the
+// mirror is not fetched and refs are resolved against its last known state.
+type GitDriver struct {
+	mutex sync.Mutex
+
+	cache     *Cache
+	gitBinary string
+}
+
+var _ sourceAPI.Driver = (*GitDriver)(nil)
+
+func NewGitDriver(opts ...GitDriverOpt) *GitDriver {
+	driver := &GitDriver{
+		gitBinary: "git",
+	}
+
+	for _, opt := range opts {
+		opt(driver)
+	}
+
+	return driver
+}
+
+func (driver *GitDriver) GetSupportedSchemes() []string {
+	return []string{"git", "git+ssh", "git+file"}
+}
+
+func (driver *GitDriver) Resolve(uri string) (afero.Fs, error) {
+	remote, err := parseRemoteUri(uri, driver.GetSupportedSchemes(), "git")
+	if err != nil {
+		return nil, err
+	}
+
+	driver.mutex.Lock()
+	defer driver.mutex.Unlock()
+
+	cache, err := driver.resolveCache()
+	if err != nil {
+		return nil, err
+	}
+
+	key := cacheKey(remote.location)
+
+	repositoryDir := cache.Path("git", "repositories", key)
+	if err := driver.syncRepository(cache, remote.location, repositoryDir); err != nil {
+		return nil, err
+	}
+
+	commit, err := driver.resolveCommit(repositoryDir, remote.ref)
+	if err != nil {
+		return nil, err
+	}
+
+	checkoutDir := cache.Path("git", "checkouts", key, commit)
+	err = cache.Populate(checkoutDir, func(fs afero.Fs) error {
+		return driver.checkout(repositoryDir, commit, fs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	fs, err := cache.Open(checkoutDir, remote.subdir)
+	if err != nil {
+		return nil, fmt.Errorf("commit %s: %w", commit, err)
+	}
+
+	return sourceAPI.NewRevisionedFs(fs, sourceAPI.Revision(commit)), nil
+}
+
+func (driver *GitDriver) resolveCache() (*Cache, error) {
+	if driver.cache != nil {
+		return driver.cache, nil
+	}
+
+	dir, err := DefaultCacheDir()
+	if err != nil {
+		return nil, err
+	}
+
+	driver.cache = NewCache(dir)
+
+	return driver.cache, nil
+}
+
+func (driver *GitDriver) syncRepository(cache *Cache, location, repositoryDir string) error {
+	exists, err := afero.DirExists(cache.osFs, repositoryDir)
+	if err != nil {
+		return fmt.Errorf("checking repository cache %s: %w", repositoryDir, err)
+	}
+
+	if cache.IsOffline() {
+		if !exists {
+			return fmt.Errorf("repository %s: %w", location, sourceAPI.ErrSourceNotCached)
+		}
+
+		return nil
+	}
+
+	if exists {
+		if _, err := driver.git("--git-dir", repositoryDir, "fetch", "--prune", "--quiet", "origin"); err != nil {
+			return fmt.Errorf("fetch %s: %w", location, err)
+		}
+
+		return nil
+	}
+
+	if err := cache.osFs.MkdirAll(filepath.Dir(repositoryDir), 0755); err != nil {
+		return fmt.Errorf("repository cache directory creation: %w", err)
+	}
+
+	if _, err := driver.git("clone", "--mirror", "--quiet", location, repositoryDir); err != nil {
+		_ = cache.osFs.RemoveAll(repositoryDir)
+		return fmt.Errorf("clone %s: %w", location, err)
+	}
+
+	return nil
+}
+
+func (driver *GitDriver) resolveCommit(repositoryDir, ref string) (string, error) {
+	if ref == "" {
+		ref = "HEAD"
+	}
+
+	output, err := driver.git("--git-dir", repositoryDir, "rev-parse", "--verify", "--quiet", ref+"^{commit}")
+	if err != nil {
+		return "", fmt.Errorf("ref %s: %w", ref, ErrRefNotFound)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+func (driver *GitDriver) checkout(repositoryDir, commit string, fs afero.Fs) error {
+	archive, err := driver.git("--git-dir", repositoryDir, "archive", "--format=tar", commit)
+	if err != nil {
+		return fmt.Errorf("archive %s: %w", commit, err)
+	}
+
+	if err := extractTar(bytes.NewReader(archive), fs); err != nil {
+		return fmt.Errorf("checkout %s: %w", commit, err)
+	}
+
+	return nil
+}
+
+func (driver *GitDriver) git(args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+
+	command := exec.Command(driver.gitBinary, args...)
+	command.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	command.Stdout = &stdout
+	command.Stderr = &stderr
+
+	if err := command.Run(); err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("%w: %s", err, message)
+	}
+
+	return stdout.Bytes(), nil
+}
+
+func cacheKey(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// ErrRefNotFound is returned when the ref requested in a git uri does not exist in the repository.
+var ErrRefNotFound = errors.New("ref not found")
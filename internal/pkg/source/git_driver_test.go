@@ -0,0 +1,279 @@
+package source
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func requireGit(t *testing.T) {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git executable not available")
+	}
+}
+
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+
+	command := exec.Command("git", append([]string{
+		"-c", "user.name=projectkit",
+		"-c", "user.email=projectkit@example.com",
+		"-c", "commit.gpgsign=false",
+		"-c", "tag.gpgsign=false",
+	}, args...)...)
+	command.Dir = dir
+	command.Env = append(os.Environ(), "GIT_CONFIG_GLOBAL=/dev/null", "GIT_CONFIG_NOSYSTEM=1")
+
+	output, err := command.CombinedOutput()
+	require.NoError(t, err, string(output))
+}
+
+func writeWorkFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+// createBareRepository creates a bare repository with a v1.0.0 tag and a newer commit on main.
+func createBareRepository(t *testing.T) (string, string) {
+	t.Helper()
+
+	root := t.TempDir()
+	workDir := filepath.Join(root, "work")
+	bareDir := filepath.Join(root, "rulebooks.git")
+	require.NoError(t, os.MkdirAll(workDir, 0o755))
+
+	runGit(t, workDir, "init", "--quiet", "--initial-branch=main")
+	writeWorkFile(t, workDir, "golang/rulebook.yaml", "version: 1\n")
+	writeWorkFile(t, workDir, "general/rulebook.yaml", "general: true\n")
+	runGit(t, workDir, "add", "-A")
+	runGit(t, workDir, "commit", "--quiet", "-m", "first")
+	runGit(t, workDir, "tag", "v1.0.0")
+
+	writeWorkFile(t, workDir, "golang/rulebook.yaml", "version: 2\n")
+	runGit(t, workDir, "commit", "--quiet", "-am", "second")
+
+	runGit(t, root, "clone", "--quiet", "--bare", workDir, bareDir)
+
+	return bareDir, workDir
+}
+
+func TestGitDriver_Resolve(t *testing.T) {
+	t.Parallel()
+	requireGit(t)
+
+	bareDir, _ := createBareRepository(t)
+	baseUri := "git+file://" + filepath.ToSlash(bareDir)
+
+	tests := []struct {
+		name            string
+		uri             string
+		expectedFile    string
+		expectedContent string
+		expectError     bool
+		errorMsg        string
+		errorIs         error
+	}{
+		{
+			name:            "WhenNoRef_ThenReturnsDefaultBranchHead",
+			uri:             baseUri,
+			expectedFile:    "golang/rulebook.yaml",
+			expectedContent: "version: 2\n",
+		},
+		{
+			name:            "WhenTagRef_ThenReturnsTaggedContent",
+			uri:             baseUri + "?ref=v1.0.0",
+			expectedFile:    "golang/rulebook.yaml",
+			expectedContent: "version: 1\n",
+		},
+		{
+			name:            "WhenBranchRef_ThenReturnsBranchContent",
+			uri:             baseUri + "?ref=main",
+			expectedFile:    "golang/rulebook.yaml",
+			expectedContent: "version: 2\n",
+		},
+		{
+			name:            "WhenSubdir_ThenScopesFsToSubdir",
+			uri:             baseUri + "?ref=v1.0.0#subdir=golang",
+			expectedFile:    "rulebook.yaml",
+			expectedContent: "version: 1\n",
+		},
+		{
+			name:        "WhenRefNotExists_ThenReturnsError",
+			uri:         baseUri + "?ref=v9.9.9",
+			expectError: true,
+			errorIs:     ErrRefNotFound,
+		},
+		{
+			name:        "WhenSubdirNotExists_ThenReturnsError",
+			uri:         baseUri + "#subdir=missing",
+			expectError: true,
+			errorMsg:    "does not exist",
+		},
+		{
+			name:        "WhenSubdirEscapesRoot_ThenReturnsError",
+			uri:         baseUri + "#subdir=../outside",
+			expectError: true,
+			errorMsg:    "escapes source root",
+		},
+		{
+			name:        "WhenRepositoryNotExists_ThenReturnsError",
+			uri:         "git+file://" + filepath.ToSlash(filepath.Join(t.TempDir(), "missing.git")),
+			expectError: true,
+			errorMsg:    "clone",
+		},
+		{
+			name:        "WhenInvalidScheme_ThenReturnsError",
+			uri:         "local://data",
+			expectError: true,
+			errorIs:     sourceAPI.ErrUnsupportedScheme,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			driver := NewGitDriver(WithGitCache(NewCache(t.TempDir())))
+
+			result, err := driver.Resolve(tt.uri)
+
+			if tt.expectError {
+				require.Error(t, err)
+				if tt.errorMsg != "" {
+					assert.Contains(t, err.Error(), tt.errorMsg)
+				}
+				if tt.errorIs != nil {
+					assert.ErrorIs(t, err, tt.errorIs)
+				}
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+
+			content, readErr := afero.ReadFile(result, tt.expectedFile)
+			require.NoError(t, readErr)
+			assert.Equal(t, tt.expectedContent, string(content))
+
+			writeErr := afero.WriteFile(result, "test.txt", []byte("content"), 0o644)
+			assert.Error(t, writeErr, "write should fail on read-only filesystem")
+
+			revision, found := sourceAPI.RevisionOf(result)
+			assert.True(t, found)
+			assert.Len(t, string(revision), 40, "revision should be a full commit hash")
+		})
+	}
+}
+
+func TestGitDriver_Resolve_WhenRemoteUpdated_ThenFetchesIntoExistingCache(t *testing.T) {
+	t.Parallel()
+	requireGit(t)
+
+	bareDir, workDir := createBareRepository(t)
+	uri := "git+file://" + filepath.ToSlash(bareDir) + "#subdir=golang"
+	driver := NewGitDriver(WithGitCache(NewCache(t.TempDir())))
+
+	first, err := driver.Resolve(uri)
+	require.NoError(t, err)
+	firstContent, err := afero.ReadFile(first, "rulebook.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "version: 2\n", string(firstContent))
+
+	writeWorkFile(t, workDir, "golang/rulebook.yaml", "version: 3\n")
+	runGit(t, workDir, "commit", "--quiet", "-am", "third")
+	runGit(t, workDir, "push", "--quiet", bareDir, "main")
+
+	second, err := driver.Resolve(uri)
+	require.NoError(t, err)
+	secondContent, err := afero.ReadFile(second, "rulebook.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "version: 3\n", string(secondContent))
+
+	firstContent, err = afero.ReadFile(first, "rulebook.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "version: 2\n", string(firstContent), "previous checkout should stay untouched")
+}
+
+func TestGitDriver_Resolve_WhenGitBinaryMissing_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	driver := NewGitDriver(
+		WithGitCache(NewCache(t.TempDir())),
+		WithGitBinary(filepath.Join(t.TempDir(), "missing-git")),
+	)
+
+	result, err := driver.Resolve("git+file:///srv/rulebooks.git")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "clone file:///srv/rulebooks.git")
+	assert.Nil(t, result)
+}
+
+func TestGitDriver_GetSupportedSchemes(t *testing.T) {
+	t.Parallel()
+
+	driver := NewGitDriver()
+
+	schemes := driver.GetSupportedSchemes()
+
+	assert.Equal(t, []string{"git", "git+ssh", "git+file"}, schemes)
+}
+
+func TestGitDriver_Resolve_WhenOffline(t *testing.T) {
+	t.Parallel()
+	requireGit(t)
+
+	bareDir, workDir := createBareRepository(t)
+	uri := "git+file://" + filepath.ToSlash(bareDir) + "#subdir=golang"
+	cacheDir := t.TempDir()
+
+	t.Run("WhenCacheCold_ThenReturnsErrSourceNotCached", func(t *testing.T) {
+		driver := NewGitDriver(WithGitCache(NewCache(t.TempDir(), WithOffline(true))))
+
+		result, err := driver.Resolve(uri)
+
+		require.ErrorIs(t, err, sourceAPI.ErrSourceNotCached)
+		assert.Nil(t, result)
+	})
+
+	onlineDriver := NewGitDriver(WithGitCache(NewCache(cacheDir)))
+	_, err := onlineDriver.Resolve(uri)
+	require.NoError(t, err)
+
+	writeWorkFile(t, workDir, "golang/rulebook.yaml", "version: 3\n")
+	runGit(t, workDir, "commit", "--quiet", "-am", "third")
+	runGit(t, workDir, "push", "--quiet", bareDir, "main")
+	require.NoError(t, os.RemoveAll(bareDir))
+
+	t.Run("WhenCacheWarm_ThenServesLastFetchedState", func(t *testing.T) {
+		driver := NewGitDriver(WithGitCache(NewCache(cacheDir, WithOffline(true))))
+
+		result, err := driver.Resolve(uri)
+
+		require.NoError(t, err)
+		content, readErr := afero.ReadFile(result, "rulebook.yaml")
+		require.NoError(t, readErr)
+		assert.Equal(t, "version: 2\n", string(content))
+	})
+
+	t.Run("WhenRefNeverFetched_ThenReturnsErrRefNotFound", func(t *testing.T) {
+		driver := NewGitDriver(WithGitCache(NewCache(cacheDir, WithOffline(true))))
+
+		result, err := driver.Resolve("git+file://" + filepath.ToSlash(bareDir) + "?ref=v9.9.9")
+
+		require.ErrorIs(t, err, ErrRefNotFound)
+		assert.Nil(t, result)
+	})
+}
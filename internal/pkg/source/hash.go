@@ -0,0 +1,58 @@
+package source
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+)
+
+const contentHashPrefix = "sha256:"
+
+// HashFs computes a SHA-256 over the paths and contents of every regular file in the filesystem.
+// Files are visited in lexical order so the hash is stable across platforms and runs.
+func HashFs(fs afero.Fs) (string, error) {
+	hash := sha256.New()
+
+	err := afero.Walk(fs, ".", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		fileHash, err := hashFile(fs, path)
+		if err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(hash, "%s\x00%s\n", filepath.ToSlash(path), fileHash)
+
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("content hashing: %w", err)
+	}
+
+	return contentHashPrefix + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func hashFile(fs afero.Fs, path string) (string, error) {
+	file, err := fs.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("file %s open: %w", path, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("file %s read: %w", path, err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
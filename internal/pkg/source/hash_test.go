@@ -0,0 +1,107 @@
+package source
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.nhat.io/aferomock"
+)
+
+func TestHashFs(t *testing.T) {
+	t.Parallel()
+
+	writeFiles := func(t *testing.T, files map[string]string) afero.Fs {
+		t.Helper()
+
+		fs := afero.NewMemMapFs()
+		for path, content := range files {
+			require.NoError(t, afero.WriteFile(fs, path, []byte(content), 0o644))
+		}
+
+		return fs
+	}
+
+	base := map[string]string{
+		"rulebook.yaml":        "name: general",
+		"ai/skills/a/skill.md": "skill",
+	}
+
+	baseHash, err := HashFs(writeFiles(t, base))
+	require.NoError(t, err)
+	assert.Regexp(t, "^sha256:[0-9a-f]{64}$", baseHash)
+
+	t.Run("WhenSameContent_ThenSameHash", func(t *testing.T) {
+		t.Parallel()
+
+		hash, err := HashFs(writeFiles(t, base))
+
+		require.NoError(t, err)
+		assert.Equal(t, baseHash, hash)
+	})
+
+	t.Run("WhenContentChanged_ThenDifferentHash", func(t *testing.T) {
+		t.Parallel()
+
+		hash, err := HashFs(writeFiles(t, map[string]string{
+			"rulebook.yaml":        "name: changed",
+			"ai/skills/a/skill.md": "skill",
+		}))
+
+		require.NoError(t, err)
+		assert.NotEqual(t, baseHash, hash)
+	})
+
+	t.Run("WhenFileRenamed_ThenDifferentHash", func(t *testing.T) {
+		t.Parallel()
+
+		hash, err := HashFs(writeFiles(t, map[string]string{
+			"rulebook.yaml":        "name: general",
+			"ai/skills/b/skill.md": "skill",
+		}))
+
+		require.NoError(t, err)
+		assert.NotEqual(t, baseHash, hash)
+	})
+
+	t.Run("WhenScopedReadOnlyFs_ThenHashesRelativePaths", func(t *testing.T) {
+		t.Parallel()
+
+		rootFs := afero.NewMemMapFs()
+		for path, content := range base {
+			require.NoError(t, afero.WriteFile(rootFs, "/srv/rulebook/"+path, []byte(content), 0o644))
+		}
+
+		hash, err := HashFs(afero.NewReadOnlyFs(afero.NewBasePathFs(rootFs, "/srv/rulebook")))
+
+		require.NoError(t, err)
+		assert.Equal(t, baseHash, hash)
+	})
+}
+
+func TestHashFs_WhenOpenFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	baseFs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(baseFs, "file.txt", []byte("content"), 0o644))
+	errFs := aferomock.OverrideFs(baseFs, aferomock.FsCallbacks{
+		OpenFunc: func(name string) (afero.File, error) {
+			if name == "file.txt" {
+				return nil, errors.New("permission denied")
+			}
+			return baseFs.Open(name)
+		},
+		StatFunc: func(name string) (os.FileInfo, error) {
+			return baseFs.Stat(name)
+		},
+	})
+
+	hash, err := HashFs(errFs)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "permission denied")
+	assert.Empty(t, hash)
+}
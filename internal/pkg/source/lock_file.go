@@ -0,0 +1,49 @@
+package source
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/go-playground/validator/v10"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+	"github.com/spf13/afero"
+	"sigs.k8s.io/yaml"
+)
+
+// LoadLock reads the lockfile from the project root.
+// Returns sourceAPI.ErrLockNotFound if the project has no lockfile.
+func LoadLock(projectFs afero.Fs) (*sourceAPI.Lock, error) {
+	content, err := afero.ReadFile(projectFs, sourceAPI.LockFileName)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, sourceAPI.ErrLockNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("lock file read: %w", err)
+	}
+
+	var lock sourceAPI.Lock
+	if err := yaml.Unmarshal(content, &lock); err != nil {
+		return nil, fmt.Errorf("lock file parse: %w", err)
+	}
+
+	if err := validator.New().Struct(lock); err != nil {
+		return nil, fmt.Errorf("lock file validation: %w", err)
+	}
+
+	return &lock, nil
+}
+
+// SaveLock writes the lockfile to the project root, replacing any previous one.
+func SaveLock(projectFs afero.Fs, lock sourceAPI.Lock) error {
+	content, err := yaml.Marshal(lock)
+	if err != nil {
+		return fmt.Errorf("lock marshal: %w", err)
+	}
+
+	if err := afero.WriteFile(projectFs, sourceAPI.LockFileName, content, 0644); err != nil {
+		return fmt.Errorf("lock file write: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package source
+
+import (
+	"testing"
+
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadLock(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		content     string
+		expected    *sourceAPI.Lock
+		expectError bool
+		errorMsg    string
+		errorIs     error
+	}{
+		{
+			name: "WhenValidLock_ThenReturnsLock",
+			content: `sources:
+  - uri: git+file:///srv/rulebooks.git?ref=v1.0.0
+    revision: 0123456789abcdef0123456789abcdef01234567
+    contentHash: sha256:abc
+`,
+			expected: &sourceAPI.Lock{Sources: []sourceAPI.LockedSource{{
+				URI:         "git+file:///srv/rulebooks.git?ref=v1.0.0",
+				Revision:    "0123456789abcdef0123456789abcdef01234567",
+				ContentHash: "sha256:abc",
+			}}},
+		},
+		{
+			name:        "WhenLockMissing_ThenReturnsErrLockNotFound",
+			expectError: true,
+			errorIs:     sourceAPI.ErrLockNotFound,
+		},
+		{
+			name:        "WhenLockMalformed_ThenReturnsError",
+			content:     "sources: [::",
+			expectError: true,
+			errorMsg:    "lock file parse",
+		},
+		{
+			name:        "WhenEntryIncomplete_ThenReturnsError",
+			content:     "sources:\n  - uri: local://rulebooks\n",
+			expectError: true,
+			errorMsg:    "lock file validation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fs := afero.NewMemMapFs()
+			if tt.content != "" {
+				require.NoError(t, afero.WriteFile(fs, sourceAPI.LockFileName, []byte(tt.content), 0o644))
+			}
+
+			lock, err := LoadLock(fs)
+
+			if tt.expectError {
+				require.Error(t, err)
+				if tt.errorMsg != "" {
+					assert.Contains(t, err.Error(), tt.errorMsg)
+				}
+				if tt.errorIs != nil {
+					assert.ErrorIs(t, err, tt.errorIs)
+				}
+				assert.Nil(t, lock)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, lock)
+		})
+	}
+}
+
+func TestSaveLock_WhenSaved_ThenLoadsBack(t *testing.T) {
+	t.Parallel()
+
+	fs := afero.NewMemMapFs()
+	lock := sourceAPI.Lock{Sources: []sourceAPI.LockedSource{
+		{URI: "local://rulebooks/general", Revision: "sha256:one", ContentHash: "sha256:one"},
+		{URI: "archive+file:///dist/rules.tgz", Revision: "sha256:two", ContentHash: "sha256:three"},
+	}}
+
+	err := SaveLock(fs, lock)
+	require.NoError(t, err)
+
+	loaded, err := LoadLock(fs)
+	require.NoError(t, err)
+	assert.Equal(t, lock, *loaded)
+}
+
+func TestSaveLock_WhenFsReadOnly_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	err := SaveLock(afero.NewReadOnlyFs(afero.NewMemMapFs()), sourceAPI.Lock{})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "lock file write")
+}
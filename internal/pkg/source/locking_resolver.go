@@ -0,0 +1,103 @@
+package source
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+	"github.com/spf13/afero"
+)
+
+// LockingResolver records the revision and content hash of every source it resolves. When created
+// with an expected lock it also rejects sources that are missing from the lock or no longer match it.
+type LockingResolver struct {
+	mutex sync.Mutex
+
+	resolver sourceAPI.Resolver
+	expected *sourceAPI.Lock
+	sources  map[string]sourceAPI.LockedSource
+}
+
+var _ sourceAPI.Resolver = (*LockingResolver)(nil)
+
+func NewLockingResolver(resolver sourceAPI.Resolver, expected *sourceAPI.Lock) *LockingResolver {
+	return &LockingResolver{
+		resolver: resolver,
+		expected: expected,
+		sources:  make(map[string]sourceAPI.LockedSource),
+	}
+}
+
+func (resolver *LockingResolver) Resolve(uri string) (afero.Fs, error) {
+	fs, err := resolver.resolver.Resolve(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	contentHash, err := HashFs(fs)
+	if err != nil {
+		return nil, fmt.Errorf("source %s: %w", uri, err)
+	}
+
+	revision, found := sourceAPI.RevisionOf(fs)
+	if !found {
+		revision = sourceAPI.Revision(contentHash)
+	}
+
+	locked := sourceAPI.LockedSource{
+		URI:         uri,
+		Revision:    revision,
+		ContentHash: contentHash,
+	}
+
+	if resolver.expected != nil {
+		if err := verifyLockedSource(*resolver.expected, locked); err != nil {
+			return nil, err
+		}
+	}
+
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+
+	resolver.sources[uri] = locked
+
+	return fs, nil
+}
+
+// Lock returns the sources resolved so far, ordered by uri.
+func (resolver *LockingResolver) Lock() sourceAPI.Lock {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+
+	lock := sourceAPI.Lock{
+		Sources: make([]sourceAPI.LockedSource, 0, len(resolver.sources)),
+	}
+	for _, source := range resolver.sources {
+		lock.Sources = append(lock.Sources, source)
+	}
+
+	slices.SortFunc(lock.Sources, func(a, b sourceAPI.LockedSource) int {
+		return strings.Compare(a.URI, b.URI)
+	})
+
+	return lock
+}
+
+func verifyLockedSource(lock sourceAPI.Lock, resolved sourceAPI.LockedSource) error {
+	expected, found := lock.FindSource(resolved.URI)
+	if !found {
+		return fmt.Errorf("source %s: %w", resolved.URI, sourceAPI.ErrSourceNotLocked)
+	}
+
+	if expected.Revision != resolved.Revision {
+		return fmt.Errorf("source %s revision %s, locked %s: %w", resolved.URI, resolved.Revision, expected.Revision, sourceAPI.ErrLockMismatch)
+	}
+
+	if expected.ContentHash != resolved.ContentHash {
+		return fmt.Errorf("source %s content hash %s, locked %s: %w", resolved.URI, resolved.ContentHash, expected.ContentHash, sourceAPI.ErrLockMismatch)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package source
+
+import (
+	"errors"
+	"testing"
+
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sourceFs(t *testing.T, content string) afero.Fs {
+	t.Helper()
+
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "rulebook.yaml", []byte(content), 0o644))
+
+	return fs
+}
+
+func TestLockingResolver_Resolve_WhenRecording_ThenLocksEverySource(t *testing.T) {
+	t.Parallel()
+
+	localFs := sourceFs(t, "name: local")
+	gitFs := sourceAPI.NewRevisionedFs(sourceFs(t, "name: git"), "0123456789abcdef")
+
+	mockResolver := sourceAPI.NewMockResolver(t)
+	mockResolver.EXPECT().Resolve("local://rulebooks").Return(localFs, nil)
+	mockResolver.EXPECT().Resolve("git+file:///srv/rulebooks.git").Return(gitFs, nil)
+
+	resolver := NewLockingResolver(mockResolver, nil)
+
+	_, err := resolver.Resolve("local://rulebooks")
+	require.NoError(t, err)
+	resolved, err := resolver.Resolve("git+file:///srv/rulebooks.git")
+	require.NoError(t, err)
+	assert.Same(t, gitFs, resolved)
+
+	localHash, err := HashFs(localFs)
+	require.NoError(t, err)
+	gitHash, err := HashFs(gitFs)
+	require.NoError(t, err)
+
+	assert.Equal(t, sourceAPI.Lock{Sources: []sourceAPI.LockedSource{
+		{URI: "git+file:///srv/rulebooks.git", Revision: "0123456789abcdef", ContentHash: gitHash},
+		{URI: "local://rulebooks", Revision: sourceAPI.Revision(localHash), ContentHash: localHash},
+	}}, resolver.Lock())
+}
+
+func TestLockingResolver_Resolve_WhenResolveFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	mockResolver := sourceAPI.NewMockResolver(t)
+	mockResolver.EXPECT().Resolve("local://missing").Return(nil, errors.New("path missing does not exist"))
+
+	resolver := NewLockingResolver(mockResolver, nil)
+
+	fs, err := resolver.Resolve("local://missing")
+
+	require.ErrorContains(t, err, "does not exist")
+	assert.Nil(t, fs)
+	assert.Empty(t, resolver.Lock().Sources)
+}
+
+func TestLockingResolver_Resolve_WhenVerifying(t *testing.T) {
+	t.Parallel()
+
+	fs := sourceAPI.NewRevisionedFs(sourceFs(t, "name: git"), "commit-a")
+	contentHash, err := HashFs(fs)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		lock    sourceAPI.Lock
+		errorIs error
+		errMsg  string
+	}{
+		{
+			name: "WhenSourceMatches_ThenReturnsFs",
+			lock: sourceAPI.Lock{Sources: []sourceAPI.LockedSource{
+				{URI: "git://example.com/rules.git", Revision: "commit-a", ContentHash: contentHash},
+			}},
+		},
+		{
+			name:    "WhenSourceNotLocked_ThenReturnsError",
+			lock:    sourceAPI.Lock{},
+			errorIs: sourceAPI.ErrSourceNotLocked,
+		},
+		{
+			name: "WhenRevisionChanged_ThenReturnsError",
+			lock: sourceAPI.Lock{Sources: []sourceAPI.LockedSource{
+				{URI: "git://example.com/rules.git", Revision: "commit-b", ContentHash: contentHash},
+			}},
+			errorIs: sourceAPI.ErrLockMismatch,
+			errMsg:  "revision commit-a, locked commit-b",
+		},
+		{
+			name: "WhenContentChanged_ThenReturnsError",
+			lock: sourceAPI.Lock{Sources: []sourceAPI.LockedSource{
+				{URI: "git://example.com/rules.git", Revision: "commit-a", ContentHash: "sha256:other"},
+			}},
+			errorIs: sourceAPI.ErrLockMismatch,
+			errMsg:  "locked sha256:other",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockResolver := sourceAPI.NewMockResolver(t)
+			mockResolver.EXPECT().Resolve("git://example.com/rules.git").Return(fs, nil)
+
+			resolver := NewLockingResolver(mockResolver, &tt.lock)
+
+			result, err := resolver.Resolve("git://example.com/rules.git")
+
+			if tt.errorIs != nil {
+				require.ErrorIs(t, err, tt.errorIs)
+				if tt.errMsg != "" {
+					assert.Contains(t, err.Error(), tt.errMsg)
+				}
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotNil(t, result)
+		})
+	}
+}
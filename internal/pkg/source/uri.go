@@ -0,0 +1,102 @@
+package source
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+)
+
+type remoteUri struct {
+	location string
+	ref      string
+	subdir   string
+}
+
+// parseRemoteUri splits a driver uri such as git+file:///srv/rulebooks.git?ref=v1.2.0#subdir=golang
+// into the transport location, the requested ref and the subdirectory the source is scoped to. Query parameters
+// other than ref belong to the location, e.g. the signature of a presigned download url.
+func parseRemoteUri(uri string, schemes []string, schemePrefix string) (remoteUri, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return remoteUri{}, fmt.Errorf("uri %s parse: %w", uri, err)
+	}
+
+	supported := false
+	for _, scheme := range schemes {
+		if parsed.Scheme == scheme {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return remoteUri{}, fmt.Errorf("uri %s: %w", uri, sourceAPI.ErrUnsupportedScheme)
+	}
+
+	fragment, err := url.ParseQuery(parsed.Fragment)
+	if err != nil {
+		return remoteUri{}, fmt.Errorf("uri %s fragment parse: %w", uri, err)
+	}
+
+	subdir, err := cleanSubdir(fragment.Get("subdir"))
+	if err != nil {
+		return remoteUri{}, fmt.Errorf("uri %s: %w", uri, err)
+	}
+
+	ref := parsed.Query().Get("ref")
+
+	location := *parsed
+	location.Scheme = strings.TrimPrefix(parsed.Scheme, schemePrefix+"+")
+	location.RawQuery = withoutQueryParameter(parsed.RawQuery, "ref")
+	location.Fragment = ""
+	location.RawFragment = ""
+
+	if location.Host == "" && location.Path == "" && location.Opaque == "" {
+		return remoteUri{}, fmt.Errorf("empty location in uri %s", uri)
+	}
+
+	return remoteUri{
+		location: location.String(),
+		ref:      ref,
+		subdir:   subdir,
+	}, nil
+}
+
+// withoutQueryParameter removes the parameter from the raw query and keeps every other parameter as written, so
+// that signed queries stay valid.
+func withoutQueryParameter(rawQuery string, name string) string {
+	var kept []string
+	for _, parameter := range strings.Split(rawQuery, "&") {
+		if parameter == "" {
+			continue
+		}
+
+		key, _, _ := strings.Cut(parameter, "=")
+		if unescaped, err := url.QueryUnescape(key); err == nil && unescaped == name {
+			continue
+		}
+
+		kept = append(kept, parameter)
+	}
+
+	return strings.Join(kept, "&")
+}
+
+func cleanSubdir(subdir string) (string, error) {
+	if subdir == "" {
+		return ".", nil
+	}
+
+	if path.IsAbs(subdir) {
+		return "", fmt.Errorf("subdir %s must be relative", subdir)
+	}
+
+	cleaned := path.Clean(subdir)
+	if cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("subdir %s escapes source root", subdir)
+	}
+
+	return cleaned, nil
+}
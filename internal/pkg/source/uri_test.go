@@ -0,0 +1,106 @@
+package source
+
+import (
+	"testing"
+
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRemoteUri(t *testing.T) {
+	t.Parallel()
+
+	schemes := []string{"git", "git+ssh", "git+file", "git+https"}
+
+	tests := []struct {
+		name        string
+		uri         string
+		expected    remoteUri
+		expectError bool
+		errorMsg    string
+		errorIs     error
+	}{
+		{
+			name: "WhenRefAndSubdir_ThenSplitsUri",
+			uri:  "git+file:///srv/rulebooks.git?ref=v1.2.0#subdir=golang",
+			expected: remoteUri{
+				location: "file:///srv/rulebooks.git",
+				ref:      "v1.2.0",
+				subdir:   "golang",
+			},
+		},
+		{
+			name: "WhenSshUri_ThenKeepsUserAndHost",
+			uri:  "git+ssh://git@github.com/orbiqd/rulebooks.git?ref=main",
+			expected: remoteUri{
+				location: "ssh://git@github.com/orbiqd/rulebooks.git",
+				ref:      "main",
+				subdir:   ".",
+			},
+		},
+		{
+			name: "WhenPlainGitScheme_ThenKeepsScheme",
+			uri:  "git://example.com/rulebooks.git#subdir=a/b/../c",
+			expected: remoteUri{
+				location: "git://example.com/rulebooks.git",
+				subdir:   "a/c",
+			},
+		},
+		{
+			name: "WhenQueryHasOtherParameters_ThenKeepsThemInLocation",
+			uri:  "git+https://example.com/rulebooks.git?token=a%2Fb&ref=main&x=1#subdir=golang",
+			expected: remoteUri{
+				location: "https://example.com/rulebooks.git?token=a%2Fb&x=1",
+				ref:      "main",
+				subdir:   "golang",
+			},
+		},
+		{
+			name:        "WhenSchemeNotSupported_ThenReturnsError",
+			uri:         "https://example.com/rulebooks.git",
+			expectError: true,
+			errorIs:     sourceAPI.ErrUnsupportedScheme,
+		},
+		{
+			name:        "WhenSubdirAbsolute_ThenReturnsError",
+			uri:         "git+file:///srv/rulebooks.git#subdir=/etc",
+			expectError: true,
+			errorMsg:    "must be relative",
+		},
+		{
+			name:        "WhenSubdirEscapes_ThenReturnsError",
+			uri:         "git+file:///srv/rulebooks.git#subdir=../..",
+			expectError: true,
+			errorMsg:    "escapes source root",
+		},
+		{
+			name:        "WhenLocationEmpty_ThenReturnsError",
+			uri:         "git+file://?ref=main",
+			expectError: true,
+			errorMsg:    "empty location",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := parseRemoteUri(tt.uri, schemes, "git")
+
+			if tt.expectError {
+				require.Error(t, err)
+				if tt.errorMsg != "" {
+					assert.Contains(t, err.Error(), tt.errorMsg)
+				}
+				if tt.errorIs != nil {
+					assert.ErrorIs(t, err, tt.errorIs)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	mcpAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
 	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
 )
 
 type Kind string
@@ -22,6 +24,12 @@ type Agent interface {
 	// RenderMCPServers renders MCP servers to the project.
 	RenderMCPServers(mcpServers []mcpAPI.MCPServer) error
 
+	// RenderTools grants the agent permission to run the commands of the given tools.
+	RenderTools(tools []toolAPI.Tool) error
+
+	// RenderWorkflows renders a command starting each workflow, so that it can be invoked from the agent.
+	RenderWorkflows(workflows []workflowAPI.Workflow) error
+
 	// GitIgnorePatterns returns patterns that should be excluded from git-commit.
 	GitIgnorePatterns() []string
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -280,3 +282,105 @@ func (_c *MockAgent_RenderMCPServers_Call) RunAndReturn(run func(mcpServers []mc
 	_c.Call.Return(run)
 	return _c
 }
+
+// RenderTools provides a mock function for the type MockAgent
+func (_mock *MockAgent) RenderTools(tools []tool.Tool) error {
+	ret := _mock.Called(tools)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenderTools")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func([]tool.Tool) error); ok {
+		r0 = returnFunc(tools)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAgent_RenderTools_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderTools'
+type MockAgent_RenderTools_Call struct {
+	*mock.Call
+}
+
+// RenderTools is a helper method to define mock.On call
+//   - tools []tool.Tool
+func (_e *MockAgent_Expecter) RenderTools(tools interface{}) *MockAgent_RenderTools_Call {
+	return &MockAgent_RenderTools_Call{Call: _e.mock.On("RenderTools", tools)}
+}
+
+func (_c *MockAgent_RenderTools_Call) Run(run func(tools []tool.Tool)) *MockAgent_RenderTools_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []tool.Tool
+		if args[0] != nil {
+			arg0 = args[0].([]tool.Tool)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAgent_RenderTools_Call) Return(err error) *MockAgent_RenderTools_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAgent_RenderTools_Call) RunAndReturn(run func(tools []tool.Tool) error) *MockAgent_RenderTools_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenderWorkflows provides a mock function for the type MockAgent
+func (_mock *MockAgent) RenderWorkflows(workflows []workflow.Workflow) error {
+	ret := _mock.Called(workflows)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenderWorkflows")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func([]workflow.Workflow) error); ok {
+		r0 = returnFunc(workflows)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAgent_RenderWorkflows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenderWorkflows'
+type MockAgent_RenderWorkflows_Call struct {
+	*mock.Call
+}
+
+// RenderWorkflows is a helper method to define mock.On call
+//   - workflows []workflow.Workflow
+func (_e *MockAgent_Expecter) RenderWorkflows(workflows interface{}) *MockAgent_RenderWorkflows_Call {
+	return &MockAgent_RenderWorkflows_Call{Call: _e.mock.On("RenderWorkflows", workflows)}
+}
+
+func (_c *MockAgent_RenderWorkflows_Call) Run(run func(workflows []workflow.Workflow)) *MockAgent_RenderWorkflows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []workflow.Workflow
+		if args[0] != nil {
+			arg0 = args[0].([]workflow.Workflow)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAgent_RenderWorkflows_Call) Return(err error) *MockAgent_RenderWorkflows_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAgent_RenderWorkflows_Call) RunAndReturn(run func(workflows []workflow.Workflow) error) *MockAgent_RenderWorkflows_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
 )
 
@@ -12,4 +13,5 @@ type Config struct {
 	Skill       *skill.Config       `json:"skill,omitempty" validate:"omitempty"`
 	Workflows   *workflow.Config    `json:"workflow,omitempty" validate:"omitempty"`
 	MCP         *mcp.Config         `json:"mcp,omitempty" validate:"omitempty"`
+	Tool        *tool.Config        `json:"tool,omitempty" validate:"omitempty"`
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -17,6 +18,7 @@ func TestLoader_Load(t *testing.T) {
 		wantLen       int
 		wantErr       error
 		checkCategory string
+		checkPath     string
 	}{
 		{
 			name: "single valid yaml file",
@@ -30,6 +32,7 @@ rules:
 			wantLen:       1,
 			wantErr:       nil,
 			checkCategory: "test-category",
+			checkPath:     "test.yaml",
 		},
 		{
 			name: "multiple valid files yaml and yml",
@@ -109,6 +112,9 @@ rules:
 				if tt.checkCategory != "" && len(got) > 0 {
 					assert.Equal(t, Category(tt.checkCategory), got[0].Category)
 				}
+				if tt.checkPath != "" && len(got) > 0 {
+					assert.Equal(t, []sourceAPI.Provenance{{Path: tt.checkPath}}, got[0].Provenance)
+				}
 			}
 		})
 	}
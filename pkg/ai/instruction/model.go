@@ -1,5 +1,7 @@
 package instruction
 
+import sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+
 type Rule string
 
 type Category string
@@ -7,4 +9,7 @@ type Category string
 type Instructions struct {
 	Category Category `json:"category" validate:"required"`
 	Rules    []Rule   `json:"rules" validate:"required,min=1"`
+
+	// Provenance lists the origin of every instruction set merged into this category, in merge order.
+	Provenance []sourceAPI.Provenance `json:"provenance,omitempty"`
 }
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	"github.com/go-playground/validator/v10"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
 	"github.com/spf13/afero"
 	"sigs.k8s.io/yaml"
 )
@@ -37,6 +38,8 @@ func (loader *Loader) Load() ([]MCPServer, error) {
 			return nil, fmt.Errorf("%s: %w", filePath, err)
 		}
 
+		server.Provenance = &sourceAPI.Provenance{Path: filePath}
+
 		result = append(result, *server)
 	}
 
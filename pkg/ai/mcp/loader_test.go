@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -17,6 +18,7 @@ func TestLoader_Load(t *testing.T) {
 		wantLen   int
 		wantErr   error
 		checkName string
+		checkPath string
 	}{
 		{
 			name: "single valid yaml file",
@@ -30,6 +32,7 @@ stdio:
 			wantLen:   1,
 			wantErr:   nil,
 			checkName: "test-server",
+			checkPath: "test.yaml",
 		},
 		{
 			name: "multiple valid files yaml and yml",
@@ -115,6 +118,9 @@ stdio:
 				if tt.checkName != "" && len(got) > 0 {
 					assert.Equal(t, tt.checkName, got[0].Name)
 				}
+				if tt.checkPath != "" && len(got) > 0 {
+					assert.Equal(t, &sourceAPI.Provenance{Path: tt.checkPath}, got[0].Provenance)
+				}
 			}
 		})
 	}
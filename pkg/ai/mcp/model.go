@@ -1,5 +1,7 @@
 package mcp
 
+import sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+
 type STDIOMCPServer struct {
 	ExecutablePath       string            `json:"executablePath" validate:"required"`
 	Arguments            []string          `json:"arguments"`
@@ -9,4 +11,6 @@ type STDIOMCPServer struct {
 type MCPServer struct {
 	Name  string          `json:"name" validate:"required"`
 	STDIO *STDIOMCPServer `json:"stdio" validate:"required"`
+
+	Provenance *sourceAPI.Provenance `json:"provenance,omitempty"`
 }
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	"github.com/go-playground/validator/v10"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
 	"github.com/spf13/afero"
 	"sigs.k8s.io/yaml"
 )
@@ -123,6 +124,7 @@ func (loader *Loader) loadSkill(path string) (*Skill, error) {
 		Metadata:     *metadata,
 		Instructions: *instructions,
 		Scripts:      scripts,
+		Provenance:   &sourceAPI.Provenance{Path: path},
 	}, nil
 }
 
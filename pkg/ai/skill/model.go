@@ -1,5 +1,7 @@
 package skill
 
+import sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+
 type Name string
 
 type Metadata struct {
@@ -18,4 +20,6 @@ type Skill struct {
 	Metadata     Metadata              `json:"metadata" validate:"required"`
 	Instructions string                `json:"instructions" validate:"required"`
 	Scripts      map[ScriptName]Script `json:"scripts,omitempty" validate:"omitempty,dive"`
+
+	Provenance *sourceAPI.Provenance `json:"provenance,omitempty"`
 }
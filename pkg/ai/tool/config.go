@@ -0,0 +1,13 @@
+package tool
+
+import sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+
+type SourceConfig struct {
+	URI string `json:"uri" validate:"required,uri"`
+
+	sourceAPI.Filter
+}
+
+type Config struct {
+	Sources []SourceConfig `json:"sources,omitempty" validate:"omitempty,min=1,dive"`
+}
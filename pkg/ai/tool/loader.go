@@ -0,0 +1,101 @@
+package tool
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/go-playground/validator/v10"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+	"github.com/spf13/afero"
+	"sigs.k8s.io/yaml"
+)
+
+type Loader struct {
+	fs afero.Fs
+}
+
+func NewLoader(fs afero.Fs) *Loader {
+	return &Loader{
+		fs: fs,
+	}
+}
+
+func (loader *Loader) Load() ([]Tool, error) {
+	filePaths, err := loader.resolveFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Tool
+	for _, filePath := range filePaths {
+		tool, err := loader.loadTool(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filePath, err)
+		}
+
+		if err := loader.validate(*tool); err != nil {
+			return nil, fmt.Errorf("%s: %w", filePath, err)
+		}
+
+		tool.Provenance = &sourceAPI.Provenance{Path: filePath}
+
+		result = append(result, *tool)
+	}
+
+	return result, nil
+}
+
+func (loader *Loader) validate(tool Tool) error {
+	validate := validator.New()
+
+	if err := validate.Struct(tool); err != nil {
+		return fmt.Errorf("%w: %v", ErrValidationFailed, err)
+	}
+
+	return nil
+}
+
+func (loader *Loader) resolveFiles() ([]string, error) {
+	entries, err := afero.ReadDir(loader.fs, ".")
+	if err != nil {
+		return nil, fmt.Errorf("read directory: %w", err)
+	}
+
+	var filePaths []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		ext := filepath.Ext(entry.Name())
+		if ext == ".yaml" || ext == ".yml" {
+			filePaths = append(filePaths, entry.Name())
+		}
+	}
+
+	if len(filePaths) == 0 {
+		return nil, ErrNoToolsFound
+	}
+
+	return filePaths, nil
+}
+
+func (loader *Loader) loadTool(filePath string) (*Tool, error) {
+	data, err := afero.ReadFile(loader.fs, filePath)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrReadFailed, err)
+	}
+
+	var tool Tool
+	if err := yaml.Unmarshal(data, &tool); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrParseFailed, err)
+	}
+
+	return &tool, nil
+}
+
+var ErrNoToolsFound = errors.New("no tools found")
+var ErrParseFailed = errors.New("parse failed")
+var ErrReadFailed = errors.New("read failed")
+var ErrValidationFailed = errors.New("validation failed")
@@ -0,0 +1,160 @@
+package tool
+
+import (
+	"errors"
+	"testing"
+
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.nhat.io/aferomock"
+)
+
+func TestLoader_Load(t *testing.T) {
+	tests := []struct {
+		name         string
+		setupFs      func(fs afero.Fs)
+		wantLen      int
+		wantErr      error
+		checkId      ToolId
+		checkCommand Command
+		checkPath    string
+	}{
+		{
+			name: "single valid yaml file",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "go-test.yaml", []byte(`metadata:
+  id: go-test
+  name: Go Test
+  description: A tool for running go tests.
+tool:
+  commands:
+    - go test
+`), 0644)
+			},
+			wantLen:      1,
+			checkId:      "go-test",
+			checkCommand: "go test",
+			checkPath:    "go-test.yaml",
+		},
+		{
+			name: "multiple valid files yaml and yml",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "go-test.yaml", []byte(`metadata:
+  id: go-test
+  name: Go Test
+  description: A tool for running go tests.
+tool:
+  commands:
+    - go test
+`), 0644)
+				_ = afero.WriteFile(fs, "go-vet.yml", []byte(`metadata:
+  id: go-vet
+  name: Go Vet
+  description: A tool for vetting go code.
+tool:
+  commands:
+    - go vet
+`), 0644)
+			},
+			wantLen: 2,
+		},
+		{
+			name: "no yaml files",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "test.txt", []byte("not yaml"), 0644)
+			},
+			wantErr: ErrNoToolsFound,
+		},
+		{
+			name: "invalid yaml syntax",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "invalid.yaml", []byte(`metadata: [invalid yaml structure
+`), 0644)
+			},
+			wantErr: ErrParseFailed,
+		},
+		{
+			name: "missing required field id",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "noid.yaml", []byte(`metadata:
+  name: Go Test
+  description: A tool for running go tests.
+tool:
+  commands:
+    - go test
+`), 0644)
+			},
+			wantErr: ErrValidationFailed,
+		},
+		{
+			name: "missing commands",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "nocommands.yaml", []byte(`metadata:
+  id: go-test
+  name: Go Test
+  description: A tool for running go tests.
+tool:
+  commands: []
+`), 0644)
+			},
+			wantErr: ErrValidationFailed,
+		},
+		{
+			name: "empty command",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "emptycommand.yaml", []byte(`metadata:
+  id: go-test
+  name: Go Test
+  description: A tool for running go tests.
+tool:
+  commands:
+    - ""
+`), 0644)
+			},
+			wantErr: ErrValidationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			tt.setupFs(fs)
+
+			loader := NewLoader(fs)
+			got, err := loader.Load()
+
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, tt.wantErr))
+			} else {
+				require.NoError(t, err)
+				assert.Len(t, got, tt.wantLen)
+				if tt.checkId != "" && len(got) > 0 {
+					assert.Equal(t, tt.checkId, got[0].Metadata.ID)
+					assert.Equal(t, []Command{tt.checkCommand}, got[0].Definition.Commands)
+				}
+				if tt.checkPath != "" && len(got) > 0 {
+					assert.Equal(t, &sourceAPI.Provenance{Path: tt.checkPath}, got[0].Provenance)
+				}
+			}
+		})
+	}
+}
+
+func TestLoader_Load_WhenReadDirFails_ThenReturnsError(t *testing.T) {
+	mockedErr := errors.New("read dir error")
+	mockFs := aferomock.OverrideFs(afero.NewMemMapFs(), aferomock.FsCallbacks{
+		OpenFunc: func(name string) (afero.File, error) {
+			return nil, mockedErr
+		},
+	})
+
+	loader := NewLoader(mockFs)
+	got, err := loader.Load()
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, mockedErr)
+	assert.Nil(t, got)
+}
@@ -0,0 +1,25 @@
+package tool
+
+import sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+
+type ToolId string
+
+// Command is a shell command prefix the tool allows agents to run, e.g. "go test".
+type Command string
+
+type Metadata struct {
+	ID          ToolId `json:"id" validate:"required"`
+	Name        string `json:"name" validate:"required"`
+	Description string `json:"description" validate:"required"`
+}
+
+type Definition struct {
+	Commands []Command `json:"commands" validate:"required,min=1,dive,required"`
+}
+
+type Tool struct {
+	Metadata   Metadata   `json:"metadata" validate:"required"`
+	Definition Definition `json:"tool" validate:"required"`
+
+	Provenance *sourceAPI.Provenance `json:"provenance,omitempty"`
+}
@@ -0,0 +1,13 @@
+package tool
+
+import "errors"
+
+type Repository interface {
+	GetAll() ([]Tool, error)
+	AddTool(tool Tool) error
+	RemoveAll() error
+}
+
+var (
+	ErrToolNotFound = errors.New("tool not found")
+)
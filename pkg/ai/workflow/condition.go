@@ -0,0 +1,476 @@
+package workflow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Condition is a parsed transition condition. Conditions compare state values with literals or with each
+// other, e.g. `addResult > 100` or `testsPassed == false || attempts < 3`. Supported are the comparison
+// operators ==, !=, <, <=, > and >=, the logical operators &&, || and !, parentheses, number, string
+// ('...' or "..."), true, false and null literals, and state variable names. A state variable that is not set
+// evaluates to null.
+type Condition struct {
+	expression string
+	root       conditionNode
+	variables  []string
+}
+
+// ParseCondition parses a transition condition.
+func ParseCondition(expression string) (*Condition, error) {
+	tokens, err := tokenizeCondition(expression)
+	if err != nil {
+		return nil, fmt.Errorf("condition %q: %w: %v", expression, ErrConditionInvalid, err)
+	}
+
+	parser := &conditionParser{tokens: tokens}
+
+	root, err := parser.parseOr()
+	if err == nil && !parser.done() {
+		err = fmt.Errorf("unexpected %q", parser.peek().text)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("condition %q: %w: %v", expression, ErrConditionInvalid, err)
+	}
+
+	return &Condition{expression: expression, root: root, variables: parser.variables}, nil
+}
+
+// Variables returns the names of the state variables the condition refers to.
+func (condition *Condition) Variables() []string {
+	return condition.variables
+}
+
+// Evaluate evaluates the condition over the given state values.
+func (condition *Condition) Evaluate(stateValues map[string]any) (bool, error) {
+	value, err := condition.root.evaluate(stateValues)
+	if err != nil {
+		return false, fmt.Errorf("condition %q: %w", condition.expression, err)
+	}
+
+	result, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("condition %q: %w", condition.expression, ErrConditionNotBoolean)
+	}
+
+	return result, nil
+}
+
+type conditionTokenKind int
+
+const (
+	tokenNumber conditionTokenKind = iota
+	tokenString
+	tokenIdentifier
+	tokenOperator
+)
+
+type conditionToken struct {
+	kind  conditionTokenKind
+	text  string
+	value any
+}
+
+func tokenizeCondition(expression string) ([]conditionToken, error) {
+	var tokens []conditionToken
+
+	runes := []rune(expression)
+	for position := 0; position < len(runes); {
+		current := runes[position]
+
+		switch {
+		case unicode.IsSpace(current):
+			position++
+
+		case current == '\'' || current == '"':
+			end := position + 1
+			for end < len(runes) && runes[end] != current {
+				end++
+			}
+			if end == len(runes) {
+				return nil, errors.New("unterminated string")
+			}
+
+			text := string(runes[position+1 : end])
+			tokens = append(tokens, conditionToken{kind: tokenString, text: text, value: text})
+			position = end + 1
+
+		case unicode.IsDigit(current) || (current == '-' && position+1 < len(runes) && unicode.IsDigit(runes[position+1])):
+			end := position + 1
+			for end < len(runes) && (unicode.IsDigit(runes[end]) || runes[end] == '.') {
+				end++
+			}
+
+			text := string(runes[position:end])
+			number, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q", text)
+			}
+
+			tokens = append(tokens, conditionToken{kind: tokenNumber, text: text, value: number})
+			position = end
+
+		case unicode.IsLetter(current) || current == '_':
+			end := position + 1
+			for end < len(runes) && (unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end]) || runes[end] == '_' || runes[end] == '-') {
+				end++
+			}
+
+			tokens = append(tokens, conditionToken{kind: tokenIdentifier, text: string(runes[position:end])})
+			position = end
+
+		default:
+			operator := ""
+			for _, candidate := range []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")"} {
+				if strings.HasPrefix(string(runes[position:]), candidate) {
+					operator = candidate
+					break
+				}
+			}
+			if operator == "" {
+				return nil, fmt.Errorf("unexpected character %q", current)
+			}
+
+			tokens = append(tokens, conditionToken{kind: tokenOperator, text: operator})
+			position += len([]rune(operator))
+		}
+	}
+
+	return tokens, nil
+}
+
+type conditionParser struct {
+	tokens    []conditionToken
+	position  int
+	variables []string
+}
+
+func (parser *conditionParser) done() bool {
+	return parser.position >= len(parser.tokens)
+}
+
+func (parser *conditionParser) peek() conditionToken {
+	return parser.tokens[parser.position]
+}
+
+func (parser *conditionParser) accept(operators ...string) (string, bool) {
+	if parser.done() {
+		return "", false
+	}
+
+	token := parser.peek()
+	if token.kind != tokenOperator {
+		return "", false
+	}
+
+	for _, operator := range operators {
+		if token.text == operator {
+			parser.position++
+			return operator, true
+		}
+	}
+
+	return "", false
+}
+
+func (parser *conditionParser) parseOr() (conditionNode, error) {
+	left, err := parser.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		if _, found := parser.accept("||"); !found {
+			return left, nil
+		}
+
+		right, err := parser.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = logicalNode{operator: "||", left: left, right: right}
+	}
+}
+
+func (parser *conditionParser) parseAnd() (conditionNode, error) {
+	left, err := parser.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		if _, found := parser.accept("&&"); !found {
+			return left, nil
+		}
+
+		right, err := parser.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		left = logicalNode{operator: "&&", left: left, right: right}
+	}
+}
+
+func (parser *conditionParser) parseNot() (conditionNode, error) {
+	if _, found := parser.accept("!"); found {
+		operand, err := parser.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		return notNode{operand: operand}, nil
+	}
+
+	return parser.parseComparison()
+}
+
+func (parser *conditionParser) parseComparison() (conditionNode, error) {
+	left, err := parser.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	operator, found := parser.accept("==", "!=", "<=", ">=", "<", ">")
+	if !found {
+		return left, nil
+	}
+
+	right, err := parser.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	return comparisonNode{operator: operator, left: left, right: right}, nil
+}
+
+func (parser *conditionParser) parseOperand() (conditionNode, error) {
+	if parser.done() {
+		return nil, errors.New("unexpected end of condition")
+	}
+
+	if _, found := parser.accept("("); found {
+		inner, err := parser.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, found := parser.accept(")"); !found {
+			return nil, errors.New("missing closing parenthesis")
+		}
+
+		return inner, nil
+	}
+
+	token := parser.peek()
+	parser.position++
+
+	switch token.kind {
+	case tokenNumber, tokenString:
+		return literalNode{value: token.value}, nil
+	case tokenIdentifier:
+		switch token.text {
+		case "true":
+			return literalNode{value: true}, nil
+		case "false":
+			return literalNode{value: false}, nil
+		case "null":
+			return literalNode{value: nil}, nil
+		}
+
+		parser.variables = append(parser.variables, token.text)
+		return variableNode{name: token.text}, nil
+	default:
+		return nil, fmt.Errorf("unexpected %q", token.text)
+	}
+}
+
+type conditionNode interface {
+	evaluate(stateValues map[string]any) (any, error)
+}
+
+type literalNode struct {
+	value any
+}
+
+func (node literalNode) evaluate(map[string]any) (any, error) {
+	return node.value, nil
+}
+
+type variableNode struct {
+	name string
+}
+
+func (node variableNode) evaluate(stateValues map[string]any) (any, error) {
+	return normalizeConditionValue(stateValues[node.name]), nil
+}
+
+type notNode struct {
+	operand conditionNode
+}
+
+func (node notNode) evaluate(stateValues map[string]any) (any, error) {
+	value, err := node.operand.evaluate(stateValues)
+	if err != nil {
+		return nil, err
+	}
+
+	result, ok := value.(bool)
+	if !ok {
+		return nil, fmt.Errorf("operand of !: %w", ErrConditionNotBoolean)
+	}
+
+	return !result, nil
+}
+
+type logicalNode struct {
+	operator string
+	left     conditionNode
+	right    conditionNode
+}
+
+func (node logicalNode) evaluate(stateValues map[string]any) (any, error) {
+	left, err := evaluateBoolean(node.left, node.operator, stateValues)
+	if err != nil {
+		return nil, err
+	}
+
+	if node.operator == "&&" && !left {
+		return false, nil
+	}
+	if node.operator == "||" && left {
+		return true, nil
+	}
+
+	return evaluateBoolean(node.right, node.operator, stateValues)
+}
+
+func evaluateBoolean(node conditionNode, operator string, stateValues map[string]any) (bool, error) {
+	value, err := node.evaluate(stateValues)
+	if err != nil {
+		return false, err
+	}
+
+	result, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("operand of %s: %w", operator, ErrConditionNotBoolean)
+	}
+
+	return result, nil
+}
+
+type comparisonNode struct {
+	operator string
+	left     conditionNode
+	right    conditionNode
+}
+
+func (node comparisonNode) evaluate(stateValues map[string]any) (any, error) {
+	left, err := node.left.evaluate(stateValues)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := node.right.evaluate(stateValues)
+	if err != nil {
+		return nil, err
+	}
+
+	switch node.operator {
+	case "==":
+		return conditionValuesEqual(left, right), nil
+	case "!=":
+		return !conditionValuesEqual(left, right), nil
+	}
+
+	// A missing value is never ordered against anything, so the comparison is simply false.
+	if left == nil || right == nil {
+		return false, nil
+	}
+
+	var order int
+	switch leftValue := left.(type) {
+	case float64:
+		rightValue, ok := right.(float64)
+		if !ok {
+			return nil, fmt.Errorf("%v %s %v: %w", left, node.operator, right, ErrConditionTypeMismatch)
+		}
+		order = compareOrdered(leftValue, rightValue)
+	case string:
+		rightValue, ok := right.(string)
+		if !ok {
+			return nil, fmt.Errorf("%v %s %v: %w", left, node.operator, right, ErrConditionTypeMismatch)
+		}
+		order = compareOrdered(leftValue, rightValue)
+	default:
+		return nil, fmt.Errorf("%v %s %v: %w", left, node.operator, right, ErrConditionTypeMismatch)
+	}
+
+	switch node.operator {
+	case "<":
+		return order < 0, nil
+	case "<=":
+		return order <= 0, nil
+	case ">":
+		return order > 0, nil
+	default:
+		return order >= 0, nil
+	}
+}
+
+func compareOrdered[T float64 | string](left T, right T) int {
+	switch {
+	case left < right:
+		return -1
+	case left > right:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func conditionValuesEqual(left any, right any) bool {
+	switch leftValue := left.(type) {
+	case nil:
+		return right == nil
+	case float64, string, bool:
+		return left == right
+	default:
+		leftData, leftErr := json.Marshal(leftValue)
+		rightData, rightErr := json.Marshal(right)
+		return leftErr == nil && rightErr == nil && string(leftData) == string(rightData)
+	}
+}
+
+// normalizeConditionValue converts numbers of any Go type to float64, so that values set in memory compare
+// the same way as values read back from JSON.
+func normalizeConditionValue(value any) any {
+	switch typed := value.(type) {
+	case int:
+		return float64(typed)
+	case int32:
+		return float64(typed)
+	case int64:
+		return float64(typed)
+	case float32:
+		return float64(typed)
+	case json.Number:
+		number, err := typed.Float64()
+		if err != nil {
+			return typed.String()
+		}
+		return number
+	default:
+		return value
+	}
+}
+
+var (
+	ErrConditionInvalid      = errors.New("invalid condition")
+	ErrConditionNotBoolean   = errors.New("condition does not evaluate to a boolean")
+	ErrConditionTypeMismatch = errors.New("cannot compare values of different types")
+)
@@ -0,0 +1,124 @@
+package workflow
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCondition_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	stateValues := map[string]any{
+		"addResult":   150,
+		"subResult":   float64(-5),
+		"jsonNumber":  json.Number("7"),
+		"testsPassed": false,
+		"status":      "failed",
+		"files":       []any{"a.go", "b.go"},
+	}
+
+	tests := []struct {
+		expression string
+		want       bool
+	}{
+		{expression: "addResult > 100", want: true},
+		{expression: "addResult <= 100", want: false},
+		{expression: "addResult >= 150 && addResult < 151", want: true},
+		{expression: "subResult < 0", want: true},
+		{expression: "subResult == -5", want: true},
+		{expression: "jsonNumber != 7", want: false},
+		{expression: "testsPassed == false", want: true},
+		{expression: "!testsPassed", want: true},
+		{expression: "testsPassed || addResult > 200", want: false},
+		{expression: "!(testsPassed || addResult > 200)", want: true},
+		{expression: `status == "failed"`, want: true},
+		{expression: "status == 'passed'", want: false},
+		{expression: "status < 'g'", want: true},
+		{expression: "missing == null", want: true},
+		{expression: "missing > 1", want: false},
+		{expression: "missing != null || addResult > 1", want: true},
+		{expression: "true", want: true},
+		{expression: "files == files", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			t.Parallel()
+
+			condition, err := ParseCondition(tt.expression)
+			require.NoError(t, err)
+
+			got, err := condition.Evaluate(stateValues)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCondition_Evaluate_WhenOperandsInvalid_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	stateValues := map[string]any{"count": 3, "name": "fix", "flag": true}
+
+	tests := []struct {
+		expression string
+		wantErr    error
+	}{
+		{expression: "count", wantErr: ErrConditionNotBoolean},
+		{expression: "count > 'two'", wantErr: ErrConditionTypeMismatch},
+		{expression: "flag > true", wantErr: ErrConditionTypeMismatch},
+		{expression: "!name", wantErr: ErrConditionNotBoolean},
+		{expression: "count && flag", wantErr: ErrConditionNotBoolean},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			t.Parallel()
+
+			condition, err := ParseCondition(tt.expression)
+			require.NoError(t, err)
+
+			_, err = condition.Evaluate(stateValues)
+
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestParseCondition_WhenSyntaxInvalid_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	expressions := []string{
+		"",
+		"addResult >",
+		"addResult > 100 100",
+		"(addResult > 100",
+		"status == 'open",
+		"addResult # 1",
+		"1.2.3 > 1",
+		"&& addResult",
+	}
+
+	for _, expression := range expressions {
+		t.Run(expression, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseCondition(expression)
+
+			require.ErrorIs(t, err, ErrConditionInvalid)
+		})
+	}
+}
+
+func TestCondition_Variables_ThenReturnsReferencedStateVariables(t *testing.T) {
+	t.Parallel()
+
+	condition, err := ParseCondition("addResult > 100 && (testsPassed == false || retries < 3)")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"addResult", "testsPassed", "retries"}, condition.Variables())
+}
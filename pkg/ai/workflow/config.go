@@ -1,9 +1,30 @@
 package workflow
 
+import sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+
 type SourceConfig struct {
 	URI string `json:"uri" validate:"required,uri"`
+
+	sourceAPI.Filter
+}
+
+type RenderConfig struct {
+	Destination string `json:"destination" validate:"required"`
+	Format      string `json:"format" validate:"required"`
+}
+
+// RetentionConfig limits how many finished executions are kept. Executions that have not finished are never
+// removed.
+type RetentionConfig struct {
+	// MaxAge removes executions finished longer ago than the duration, e.g. "720h".
+	MaxAge string `json:"maxAge,omitempty"`
+
+	// MaxCount keeps at most this many finished executions of each workflow, removing the oldest first.
+	MaxCount int `json:"maxCount,omitempty" validate:"omitempty,min=1"`
 }
 
 type Config struct {
-	Sources []SourceConfig `json:"sources,omitempty" validate:"omitempty,min=1,dive"`
+	Render    []RenderConfig   `json:"render,omitempty" validate:"omitempty,dive"`
+	Retention *RetentionConfig `json:"retention,omitempty" validate:"omitempty"`
+	Sources   []SourceConfig   `json:"sources,omitempty" validate:"omitempty,min=1,dive"`
 }
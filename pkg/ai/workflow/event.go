@@ -0,0 +1,67 @@
+package workflow
+
+import "time"
+
+type EventType string
+
+const (
+	EventTypeStarted       EventType = "started"
+	EventTypeStepEntered   EventType = "step-entered"
+	EventTypeStateChanged  EventType = "state-changed"
+	EventTypeStepCompleted EventType = "step-completed"
+	EventTypeApproved      EventType = "approved"
+	EventTypeRejected      EventType = "rejected"
+	EventTypeCompleted     EventType = "completed"
+	EventTypeAborted       EventType = "aborted"
+	EventTypeSuspended     EventType = "suspended"
+	EventTypeResumed       EventType = "resumed"
+	EventTypeMigrated      EventType = "migrated"
+)
+
+type ActorKind string
+
+const (
+	ActorKindAgent ActorKind = "agent"
+	ActorKindHuman ActorKind = "human"
+)
+
+// Actor is whoever caused an execution event.
+type Actor struct {
+	Kind ActorKind `json:"kind"`
+
+	// Name identifies the actor, e.g. the name the agent reports when connecting to the MCP server.
+	Name string `json:"name,omitempty"`
+}
+
+func (actor Actor) String() string {
+	if actor.Name == "" {
+		return string(actor.Kind)
+	}
+
+	return string(actor.Kind) + ":" + actor.Name
+}
+
+// Event is a single entry of the append-only history of an execution.
+type Event struct {
+	Time  time.Time `json:"time"`
+	Type  EventType `json:"type"`
+	Actor Actor     `json:"actor"`
+
+	// StepId is the step the event happened at.
+	StepId string `json:"stepId,omitempty"`
+
+	// Key, OldValue and NewValue describe a state change.
+	Key      string `json:"key,omitempty"`
+	OldValue any    `json:"oldValue,omitempty"`
+	NewValue any    `json:"newValue,omitempty"`
+
+	// Reason explains a rejection.
+	Reason string `json:"reason,omitempty"`
+
+	// RelatedExecutionId is the sub-workflow execution an execution was suspended for or resumed after, or the
+	// parent execution that started a sub-workflow execution.
+	RelatedExecutionId ExecutionId `json:"relatedExecutionId,omitempty"`
+
+	// WorkflowVersion is the workflow version an execution was started with or migrated to.
+	WorkflowVersion string `json:"workflowVersion,omitempty"`
+}
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
 	"github.com/spf13/afero"
 	"sigs.k8s.io/yaml"
 )
@@ -79,6 +80,8 @@ func (loader *Loader) loadWorkflow(path string) (*Workflow, error) {
 		return nil, fmt.Errorf("%w: %v", ErrValidationFailed, err)
 	}
 
+	workflow.Provenance = &sourceAPI.Provenance{Path: path}
+
 	return &workflow, nil
 }
 
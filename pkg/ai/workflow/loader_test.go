@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -231,6 +232,7 @@ func TestLoader_Load(t *testing.T) {
 		wantLen    int
 		wantErr    error
 		errContain string
+		wantPath   string
 	}{
 		{
 			name: "single workflow",
@@ -248,8 +250,9 @@ steps:
       - Do something`
 				_ = afero.WriteFile(fs, "workflow1.yaml", []byte(content), 0644)
 			},
-			wantLen: 1,
-			wantErr: nil,
+			wantLen:  1,
+			wantErr:  nil,
+			wantPath: "workflow1.yaml",
 		},
 		{
 			name: "multiple workflows",
@@ -317,6 +320,9 @@ steps:
 			} else {
 				require.NoError(t, err)
 				assert.Len(t, got, tt.wantLen)
+				if tt.wantPath != "" {
+					assert.Equal(t, &sourceAPI.Provenance{Path: tt.wantPath}, got[0].Provenance)
+				}
 			}
 		})
 	}
@@ -1,6 +1,14 @@
 package workflow
 
-import "github.com/invopop/jsonschema"
+import (
+	"fmt"
+	"time"
+
+	"github.com/invopop/jsonschema"
+	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+)
 
 type WorkflowId string
 type ExecutionId string
@@ -12,23 +20,196 @@ type Metadata struct {
 	Version     string     `json:"version" validate:"required,semver"`
 }
 
+// Transition moves an execution to another step when its condition holds over the state values.
+type Transition struct {
+	// When is the condition of the transition, see Condition. An empty condition always holds.
+	When string `json:"when,omitempty"`
+
+	// Next is the ID of the step the execution moves to.
+	Next string `json:"next" validate:"required"`
+}
+
+// Approval requires a human to sign off before an execution starts working on a step.
+type Approval struct {
+	// Message tells the approver what to check before approving.
+	Message string `json:"message,omitempty"`
+}
+
+// Invocation runs another workflow as a step. The execution is suspended while a child execution of the invoked
+// workflow runs, and continues past the step once the child completes.
+type Invocation struct {
+	WorkflowId WorkflowId `json:"workflowId" validate:"required"`
+
+	// Inputs maps state variables of the invoked workflow to the state variables of this workflow they are
+	// copied from when the child execution starts.
+	Inputs map[string]string `json:"inputs,omitempty"`
+
+	// Outputs maps state variables of this workflow to the state variables of the invoked workflow they are
+	// copied from when the child execution completes.
+	Outputs map[string]string `json:"outputs,omitempty"`
+}
+
 type Step struct {
-	ID           string   `json:"id" validate:"required"`
-	Name         string   `json:"name" validate:"required"`
-	Description  string   `json:"description" validate:"required"`
-	Instructions []string `json:"instructions" validate:"required,min=1"`
+	ID          string `json:"id" validate:"required"`
+	Name        string `json:"name" validate:"required"`
+	Description string `json:"description" validate:"required"`
+
+	// Instructions may only be left out by a step that invokes another workflow.
+	Instructions []string `json:"instructions,omitempty" validate:"required_without=Workflow,omitempty,min=1"`
+
+	// Skills lists the skills the agent uses to carry out the instructions.
+	Skills []skillAPI.Name `json:"skills,omitempty" validate:"omitempty,dive,required"`
+
+	// Tools lists the tools the agent uses to carry out the instructions.
+	Tools []toolAPI.ToolId `json:"tools,omitempty" validate:"omitempty,dive,required"`
+
+	// Outputs lists the state variables the step must set before the execution can advance past it.
+	Outputs []string `json:"outputs,omitempty" validate:"omitempty,dive,required"`
+
+	// Transitions are evaluated in order once the step is completed; the first one that holds chooses the next
+	// step. Without a matching transition the execution moves to the following step in the list.
+	Transitions []Transition `json:"transitions,omitempty" validate:"omitempty,dive"`
+
+	// Terminal completes the execution when the step is completed and none of its transitions holds.
+	Terminal bool `json:"terminal,omitempty"`
+
+	// Approval pauses an execution entering the step until a human approves or rejects it.
+	Approval *Approval `json:"approval,omitempty"`
+
+	// Workflow makes the step run another workflow, see Invocation.
+	Workflow *Invocation `json:"workflow,omitempty" validate:"omitempty"`
 }
 
 type Workflow struct {
 	Metadata Metadata                      `json:"metadata" validate:"required"`
 	State    map[string]*jsonschema.Schema `json:"state,omitempty" validate:"omitempty"`
 	Steps    []Step                        `json:"steps" validate:"required,min=1,dive"`
+
+	Provenance *sourceAPI.Provenance `json:"provenance,omitempty"`
+}
+
+// ExecutionStatus is the lifecycle status of a workflow execution.
+type ExecutionStatus string
+
+const (
+	// ExecutionStatusPending marks an execution that was created but has not made any progress yet.
+	ExecutionStatusPending ExecutionStatus = "pending"
+
+	// ExecutionStatusRunning marks an execution whose current step is being worked on.
+	ExecutionStatusRunning ExecutionStatus = "running"
+
+	// ExecutionStatusAwaitingApproval marks an execution paused before a step that requires human approval.
+	ExecutionStatusAwaitingApproval ExecutionStatus = "awaiting-approval"
+
+	// ExecutionStatusSuspended marks an execution waiting for the child execution of a workflow its current step
+	// invokes.
+	ExecutionStatusSuspended ExecutionStatus = "suspended"
+
+	// ExecutionStatusCompleted marks an execution that advanced past its last step.
+	ExecutionStatusCompleted ExecutionStatus = "completed"
+
+	// ExecutionStatusAborted marks an execution that was stopped before completion.
+	ExecutionStatusAborted ExecutionStatus = "aborted"
+)
+
+// IsFinished reports whether the status is final, so the execution can no longer change.
+func (status ExecutionStatus) IsFinished() bool {
+	return status == ExecutionStatusCompleted || status == ExecutionStatusAborted
 }
 
 type Execution struct {
 	Id         ExecutionId `json:"id" validate:"required"`
 	WorkflowId WorkflowId  `json:"workflowId" validate:"required"`
 
-	StateValues map[string]any `json:"stateValues" validate:"required"`
-	StepId      string         `json:"stepId" validate:"required"`
+	// WorkflowVersion is the version of the workflow the execution runs. The execution keeps running that version
+	// after the workflow is updated, until it is migrated to the new one. Executions started before versions were
+	// recorded leave it empty and run the current version.
+	WorkflowVersion string `json:"workflowVersion,omitempty"`
+
+	Status      ExecutionStatus `json:"status" validate:"required,oneof=pending running awaiting-approval suspended completed aborted"`
+	StateValues map[string]any  `json:"stateValues" validate:"required"`
+	StepId      string          `json:"stepId" validate:"required"`
+
+	// PreviousStepId is the step completed before the current one, empty while on the first step.
+	PreviousStepId string `json:"previousStepId,omitempty"`
+
+	// ParentExecutionId is the execution whose step started this one as a sub-workflow.
+	ParentExecutionId ExecutionId `json:"parentExecutionId,omitempty"`
+
+	// ChildExecutionId is the sub-workflow execution a suspended execution waits for.
+	ChildExecutionId ExecutionId `json:"childExecutionId,omitempty"`
+
+	// Rejection is the last rejected approval, kept until the execution completes another step.
+	Rejection *Rejection `json:"rejection,omitempty"`
+
+	// CompletedAt and AbortedAt tell when the execution finished. Executions finished before the times were
+	// recorded leave both empty.
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	AbortedAt   *time.Time `json:"abortedAt,omitempty"`
+
+	// Revision counts the stored updates of the execution. An update must carry the revision the execution was
+	// read at, so that a change made by a concurrent writer in the meantime is detected instead of overwritten.
+	Revision int `json:"revision"`
+}
+
+// FinishedAt returns when the execution completed or was aborted, or nil while it runs or when the time was not
+// recorded.
+func (execution Execution) FinishedAt() *time.Time {
+	if execution.CompletedAt != nil {
+		return execution.CompletedAt
+	}
+
+	return execution.AbortedAt
+}
+
+// Rejection records why a human rejected the approval of a step.
+type Rejection struct {
+	StepId string `json:"stepId"`
+	Reason string `json:"reason"`
+}
+
+// NextStep returns the ID of the step that follows the completed step with the given ID, or completed set when
+// the execution ends with it.
+func (workflow Workflow) NextStep(stepId string, stateValues map[string]any) (next string, completed bool, err error) {
+	index, found := workflow.StepIndex(stepId)
+	if !found {
+		return "", false, fmt.Errorf("step %s: %w", stepId, ErrStepNotFound)
+	}
+
+	step := workflow.Steps[index]
+	for _, transition := range step.Transitions {
+		if transition.When == "" {
+			return transition.Next, false, nil
+		}
+
+		condition, err := ParseCondition(transition.When)
+		if err != nil {
+			return "", false, fmt.Errorf("step %s: %w", stepId, err)
+		}
+
+		holds, err := condition.Evaluate(stateValues)
+		if err != nil {
+			return "", false, fmt.Errorf("step %s: %w", stepId, err)
+		}
+		if holds {
+			return transition.Next, false, nil
+		}
+	}
+
+	if step.Terminal || index == len(workflow.Steps)-1 {
+		return "", true, nil
+	}
+
+	return workflow.Steps[index+1].ID, false, nil
+}
+
+// StepIndex returns the position of the step with the given ID.
+func (workflow Workflow) StepIndex(stepId string) (int, bool) {
+	for index, step := range workflow.Steps {
+		if step.ID == stepId {
+			return index, true
+		}
+	}
+
+	return 0, false
 }
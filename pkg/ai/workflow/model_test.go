@@ -142,3 +142,103 @@ func TestExecutionId_Validate_WhenEmptyString_ThenReturnsError(t *testing.T) {
 	err := id.Validate()
 	require.ErrorIs(t, err, ErrExecutionInvalidID)
 }
+
+func TestExecutionStatus_IsFinished(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		status ExecutionStatus
+		want   bool
+	}{
+		{status: ExecutionStatusPending, want: false},
+		{status: ExecutionStatusRunning, want: false},
+		{status: ExecutionStatusCompleted, want: true},
+		{status: ExecutionStatusAborted, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.status.IsFinished())
+		})
+	}
+}
+
+func TestWorkflow_StepIndex(t *testing.T) {
+	t.Parallel()
+
+	workflow := Workflow{Steps: []Step{{ID: "first"}, {ID: "second"}}}
+
+	index, found := workflow.StepIndex("second")
+	assert.True(t, found)
+	assert.Equal(t, 1, index)
+
+	_, found = workflow.StepIndex("missing")
+	assert.False(t, found)
+}
+
+func TestWorkflow_NextStep(t *testing.T) {
+	t.Parallel()
+
+	workflow := Workflow{
+		Steps: []Step{
+			{ID: "implement"},
+			{
+				ID: "test",
+				Transitions: []Transition{
+					{When: "testsPassed == false", Next: "implement"},
+				},
+			},
+			{ID: "review", Terminal: true, Transitions: []Transition{{When: "approved == false", Next: "implement"}}},
+			{ID: "cleanup", Transitions: []Transition{{Next: "review"}}},
+			{ID: "release"},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		stepId        string
+		stateValues   map[string]any
+		wantNext      string
+		wantCompleted bool
+	}{
+		{name: "falls through to following step", stepId: "implement", wantNext: "test"},
+		{name: "condition holds", stepId: "test", stateValues: map[string]any{"testsPassed": false}, wantNext: "implement"},
+		{name: "condition does not hold", stepId: "test", stateValues: map[string]any{"testsPassed": true}, wantNext: "review"},
+		{name: "terminal step with transition taken", stepId: "review", stateValues: map[string]any{"approved": false}, wantNext: "implement"},
+		{name: "terminal step completes", stepId: "review", stateValues: map[string]any{"approved": true}, wantCompleted: true},
+		{name: "unconditional transition", stepId: "cleanup", wantNext: "review"},
+		{name: "last step completes", stepId: "release", wantCompleted: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			next, completed, err := workflow.NextStep(tt.stepId, tt.stateValues)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantNext, next)
+			assert.Equal(t, tt.wantCompleted, completed)
+		})
+	}
+}
+
+func TestWorkflow_NextStep_WhenStepUnknown_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := Workflow{Steps: []Step{{ID: "only"}}}.NextStep("missing", nil)
+
+	require.ErrorIs(t, err, ErrStepNotFound)
+}
+
+func TestWorkflow_NextStep_WhenConditionFails_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	workflow := Workflow{Steps: []Step{{ID: "only", Transitions: []Transition{{When: "count", Next: "only"}}}}}
+
+	_, _, err := workflow.NextStep("only", map[string]any{"count": 1})
+
+	require.ErrorIs(t, err, ErrConditionNotBoolean)
+}
@@ -0,0 +1,7 @@
+package workflow
+
+// Renderer turns a workflow into a documentation file.
+type Renderer interface {
+	Render(workflow Workflow) ([]byte, error)
+	FileExtension() string
+}
@@ -25,19 +25,43 @@ type Repository interface {
 	AddWorkflow(workflow Workflow) error
 	GetAllWorkflows() ([]Workflow, error)
 	GetWorkflowById(id WorkflowId) (*Workflow, error)
+
+	// GetWorkflowVersion returns the given version of the workflow. It is the current workflow when the version
+	// matches or is empty, otherwise a version kept for the unfinished executions still running it.
+	GetWorkflowVersion(id WorkflowId, version string) (*Workflow, error)
+
+	// RemoveAllWorkflows removes all workflows. Versions that unfinished executions still run are kept, so that
+	// the executions can go on after the workflows are replaced by new versions.
 	RemoveAllWorkflows() error
 
 	AddExecution(execution Execution) error
-	UpdateExecution(execution Execution) error
+
+	// UpdateExecution stores the execution unless it was updated since it was read, as told by its revision, in
+	// which case ErrExecutionConflict is returned. The stored revision is incremented. The events describing the
+	// change are appended to the history together with it: either both are stored or neither is.
+	UpdateExecution(execution Execution, events []Event) error
 	GetExecutionById(id ExecutionId) (*Execution, error)
+	GetAllExecutions() ([]Execution, error)
+
+	// ListExecutions returns the executions of the workflow.
+	ListExecutions(workflowId WorkflowId) ([]Execution, error)
+
+	// RemoveExecution removes the execution together with its history.
+	RemoveExecution(id ExecutionId) error
+
+	AppendEvent(id ExecutionId, event Event) error
+	GetEvents(id ExecutionId) ([]Event, error)
 }
 
 var (
-	ErrWorkflowNotFound      = errors.New("workflow not found")
-	ErrWorkflowAlreadyExists = errors.New("workflow already exists")
-	ErrWorkflowInvalidID     = errors.New("workflow id must be alphanumeric with dashes")
+	ErrWorkflowNotFound        = errors.New("workflow not found")
+	ErrWorkflowAlreadyExists   = errors.New("workflow already exists")
+	ErrWorkflowInvalidID       = errors.New("workflow id must be alphanumeric with dashes")
+	ErrWorkflowVersionNotFound = errors.New("workflow version not found")
 
 	ErrExecutionNotFound      = errors.New("execution not found")
 	ErrExecutionAlreadyExists = errors.New("execution already exists")
 	ErrExecutionInvalidID     = errors.New("execution id must be alphanumeric with dashes")
+	ErrExecutionConflict      = errors.New("execution was changed by another writer")
+	ErrExecutionLocked        = errors.New("execution is locked by another writer")
 )
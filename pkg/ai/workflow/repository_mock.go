@@ -137,6 +137,118 @@ func (_c *MockRepository_AddWorkflow_Call) RunAndReturn(run func(workflow Workfl
 	return _c
 }
 
+// AppendEvent provides a mock function for the type MockRepository
+func (_mock *MockRepository) AppendEvent(id ExecutionId, event Event) error {
+	ret := _mock.Called(id, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppendEvent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(ExecutionId, Event) error); ok {
+		r0 = returnFunc(id, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_AppendEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppendEvent'
+type MockRepository_AppendEvent_Call struct {
+	*mock.Call
+}
+
+// AppendEvent is a helper method to define mock.On call
+//   - id ExecutionId
+//   - event Event
+func (_e *MockRepository_Expecter) AppendEvent(id interface{}, event interface{}) *MockRepository_AppendEvent_Call {
+	return &MockRepository_AppendEvent_Call{Call: _e.mock.On("AppendEvent", id, event)}
+}
+
+func (_c *MockRepository_AppendEvent_Call) Run(run func(id ExecutionId, event Event)) *MockRepository_AppendEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 ExecutionId
+		if args[0] != nil {
+			arg0 = args[0].(ExecutionId)
+		}
+		var arg1 Event
+		if args[1] != nil {
+			arg1 = args[1].(Event)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_AppendEvent_Call) Return(err error) *MockRepository_AppendEvent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_AppendEvent_Call) RunAndReturn(run func(id ExecutionId, event Event) error) *MockRepository_AppendEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllExecutions provides a mock function for the type MockRepository
+func (_mock *MockRepository) GetAllExecutions() ([]Execution, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllExecutions")
+	}
+
+	var r0 []Execution
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]Execution, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []Execution); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Execution)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_GetAllExecutions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllExecutions'
+type MockRepository_GetAllExecutions_Call struct {
+	*mock.Call
+}
+
+// GetAllExecutions is a helper method to define mock.On call
+func (_e *MockRepository_Expecter) GetAllExecutions() *MockRepository_GetAllExecutions_Call {
+	return &MockRepository_GetAllExecutions_Call{Call: _e.mock.On("GetAllExecutions")}
+}
+
+func (_c *MockRepository_GetAllExecutions_Call) Run(run func()) *MockRepository_GetAllExecutions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetAllExecutions_Call) Return(executions []Execution, err error) *MockRepository_GetAllExecutions_Call {
+	_c.Call.Return(executions, err)
+	return _c
+}
+
+func (_c *MockRepository_GetAllExecutions_Call) RunAndReturn(run func() ([]Execution, error)) *MockRepository_GetAllExecutions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllWorkflows provides a mock function for the type MockRepository
 func (_mock *MockRepository) GetAllWorkflows() ([]Workflow, error) {
 	ret := _mock.Called()
@@ -192,6 +304,68 @@ func (_c *MockRepository_GetAllWorkflows_Call) RunAndReturn(run func() ([]Workfl
 	return _c
 }
 
+// GetEvents provides a mock function for the type MockRepository
+func (_mock *MockRepository) GetEvents(id ExecutionId) ([]Event, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEvents")
+	}
+
+	var r0 []Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(ExecutionId) ([]Event, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(ExecutionId) []Event); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(ExecutionId) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_GetEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEvents'
+type MockRepository_GetEvents_Call struct {
+	*mock.Call
+}
+
+// GetEvents is a helper method to define mock.On call
+//   - id ExecutionId
+func (_e *MockRepository_Expecter) GetEvents(id interface{}) *MockRepository_GetEvents_Call {
+	return &MockRepository_GetEvents_Call{Call: _e.mock.On("GetEvents", id)}
+}
+
+func (_c *MockRepository_GetEvents_Call) Run(run func(id ExecutionId)) *MockRepository_GetEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 ExecutionId
+		if args[0] != nil {
+			arg0 = args[0].(ExecutionId)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetEvents_Call) Return(events []Event, err error) *MockRepository_GetEvents_Call {
+	_c.Call.Return(events, err)
+	return _c
+}
+
+func (_c *MockRepository_GetEvents_Call) RunAndReturn(run func(id ExecutionId) ([]Event, error)) *MockRepository_GetEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetExecutionById provides a mock function for the type MockRepository
 func (_mock *MockRepository) GetExecutionById(id ExecutionId) (*Execution, error) {
 	ret := _mock.Called(id)
@@ -316,6 +490,136 @@ func (_c *MockRepository_GetWorkflowById_Call) RunAndReturn(run func(id Workflow
 	return _c
 }
 
+// GetWorkflowVersion provides a mock function for the type MockRepository
+func (_mock *MockRepository) GetWorkflowVersion(id WorkflowId, version string) (*Workflow, error) {
+	ret := _mock.Called(id, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkflowVersion")
+	}
+
+	var r0 *Workflow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(WorkflowId, string) (*Workflow, error)); ok {
+		return returnFunc(id, version)
+	}
+	if returnFunc, ok := ret.Get(0).(func(WorkflowId, string) *Workflow); ok {
+		r0 = returnFunc(id, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Workflow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(WorkflowId, string) error); ok {
+		r1 = returnFunc(id, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_GetWorkflowVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkflowVersion'
+type MockRepository_GetWorkflowVersion_Call struct {
+	*mock.Call
+}
+
+// GetWorkflowVersion is a helper method to define mock.On call
+//   - id WorkflowId
+//   - version string
+func (_e *MockRepository_Expecter) GetWorkflowVersion(id interface{}, version interface{}) *MockRepository_GetWorkflowVersion_Call {
+	return &MockRepository_GetWorkflowVersion_Call{Call: _e.mock.On("GetWorkflowVersion", id, version)}
+}
+
+func (_c *MockRepository_GetWorkflowVersion_Call) Run(run func(id WorkflowId, version string)) *MockRepository_GetWorkflowVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 WorkflowId
+		if args[0] != nil {
+			arg0 = args[0].(WorkflowId)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetWorkflowVersion_Call) Return(workflow *Workflow, err error) *MockRepository_GetWorkflowVersion_Call {
+	_c.Call.Return(workflow, err)
+	return _c
+}
+
+func (_c *MockRepository_GetWorkflowVersion_Call) RunAndReturn(run func(id WorkflowId, version string) (*Workflow, error)) *MockRepository_GetWorkflowVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExecutions provides a mock function for the type MockRepository
+func (_mock *MockRepository) ListExecutions(workflowId WorkflowId) ([]Execution, error) {
+	ret := _mock.Called(workflowId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExecutions")
+	}
+
+	var r0 []Execution
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(WorkflowId) ([]Execution, error)); ok {
+		return returnFunc(workflowId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(WorkflowId) []Execution); ok {
+		r0 = returnFunc(workflowId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Execution)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(WorkflowId) error); ok {
+		r1 = returnFunc(workflowId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ListExecutions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExecutions'
+type MockRepository_ListExecutions_Call struct {
+	*mock.Call
+}
+
+// ListExecutions is a helper method to define mock.On call
+//   - workflowId WorkflowId
+func (_e *MockRepository_Expecter) ListExecutions(workflowId interface{}) *MockRepository_ListExecutions_Call {
+	return &MockRepository_ListExecutions_Call{Call: _e.mock.On("ListExecutions", workflowId)}
+}
+
+func (_c *MockRepository_ListExecutions_Call) Run(run func(workflowId WorkflowId)) *MockRepository_ListExecutions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 WorkflowId
+		if args[0] != nil {
+			arg0 = args[0].(WorkflowId)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListExecutions_Call) Return(executions []Execution, err error) *MockRepository_ListExecutions_Call {
+	_c.Call.Return(executions, err)
+	return _c
+}
+
+func (_c *MockRepository_ListExecutions_Call) RunAndReturn(run func(workflowId WorkflowId) ([]Execution, error)) *MockRepository_ListExecutions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveAllWorkflows provides a mock function for the type MockRepository
 func (_mock *MockRepository) RemoveAllWorkflows() error {
 	ret := _mock.Called()
@@ -360,17 +664,68 @@ func (_c *MockRepository_RemoveAllWorkflows_Call) RunAndReturn(run func() error)
 	return _c
 }
 
+// RemoveExecution provides a mock function for the type MockRepository
+func (_mock *MockRepository) RemoveExecution(id ExecutionId) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveExecution")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(ExecutionId) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_RemoveExecution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveExecution'
+type MockRepository_RemoveExecution_Call struct {
+	*mock.Call
+}
+
+// RemoveExecution is a helper method to define mock.On call
+//   - id ExecutionId
+func (_e *MockRepository_Expecter) RemoveExecution(id interface{}) *MockRepository_RemoveExecution_Call {
+	return &MockRepository_RemoveExecution_Call{Call: _e.mock.On("RemoveExecution", id)}
+}
+
+func (_c *MockRepository_RemoveExecution_Call) Run(run func(id ExecutionId)) *MockRepository_RemoveExecution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 ExecutionId
+		if args[0] != nil {
+			arg0 = args[0].(ExecutionId)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_RemoveExecution_Call) Return(err error) *MockRepository_RemoveExecution_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_RemoveExecution_Call) RunAndReturn(run func(id ExecutionId) error) *MockRepository_RemoveExecution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateExecution provides a mock function for the type MockRepository
-func (_mock *MockRepository) UpdateExecution(execution Execution) error {
-	ret := _mock.Called(execution)
+func (_mock *MockRepository) UpdateExecution(execution Execution, events []Event) error {
+	ret := _mock.Called(execution, events)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateExecution")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(Execution) error); ok {
-		r0 = returnFunc(execution)
+	if returnFunc, ok := ret.Get(0).(func(Execution, []Event) error); ok {
+		r0 = returnFunc(execution, events)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -384,18 +739,24 @@ type MockRepository_UpdateExecution_Call struct {
 
 // UpdateExecution is a helper method to define mock.On call
 //   - execution Execution
-func (_e *MockRepository_Expecter) UpdateExecution(execution interface{}) *MockRepository_UpdateExecution_Call {
-	return &MockRepository_UpdateExecution_Call{Call: _e.mock.On("UpdateExecution", execution)}
+//   - events []Event
+func (_e *MockRepository_Expecter) UpdateExecution(execution interface{}, events interface{}) *MockRepository_UpdateExecution_Call {
+	return &MockRepository_UpdateExecution_Call{Call: _e.mock.On("UpdateExecution", execution, events)}
 }
 
-func (_c *MockRepository_UpdateExecution_Call) Run(run func(execution Execution)) *MockRepository_UpdateExecution_Call {
+func (_c *MockRepository_UpdateExecution_Call) Run(run func(execution Execution, events []Event)) *MockRepository_UpdateExecution_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 Execution
 		if args[0] != nil {
 			arg0 = args[0].(Execution)
 		}
+		var arg1 []Event
+		if args[1] != nil {
+			arg1 = args[1].([]Event)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -406,7 +767,7 @@ func (_c *MockRepository_UpdateExecution_Call) Return(err error) *MockRepository
 	return _c
 }
 
-func (_c *MockRepository_UpdateExecution_Call) RunAndReturn(run func(execution Execution) error) *MockRepository_UpdateExecution_Call {
+func (_c *MockRepository_UpdateExecution_Call) RunAndReturn(run func(execution Execution, events []Event) error) *MockRepository_UpdateExecution_Call {
 	_c.Call.Return(run)
 	return _c
 }
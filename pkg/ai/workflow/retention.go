@@ -0,0 +1,72 @@
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Expired returns the finished executions the retention does not keep at the given time. Executions finished
+// longer ago than MaxAge are expired, and so are the oldest finished executions of each workflow beyond
+// MaxCount. Executions without a recorded finish time count as the oldest, but are never expired by age.
+func (retention RetentionConfig) Expired(executions []Execution, now time.Time) ([]Execution, error) {
+	var maxAge time.Duration
+	if retention.MaxAge != "" {
+		parsed, err := time.ParseDuration(retention.MaxAge)
+		if err != nil || parsed <= 0 {
+			return nil, fmt.Errorf("max age %q: %w", retention.MaxAge, ErrRetentionMaxAgeInvalid)
+		}
+		maxAge = parsed
+	}
+
+	finishedByWorkflow := map[WorkflowId][]Execution{}
+	for _, execution := range executions {
+		if execution.Status.IsFinished() {
+			finishedByWorkflow[execution.WorkflowId] = append(finishedByWorkflow[execution.WorkflowId], execution)
+		}
+	}
+
+	var expired []Execution
+	for _, finished := range finishedByWorkflow {
+		sort.SliceStable(finished, func(i, j int) bool {
+			return finishedBefore(finished[j], finished[i])
+		})
+
+		for index, execution := range finished {
+			finishedAt := execution.FinishedAt()
+
+			switch {
+			case retention.MaxCount > 0 && index >= retention.MaxCount:
+				expired = append(expired, execution)
+			case maxAge > 0 && finishedAt != nil && now.Sub(*finishedAt) > maxAge:
+				expired = append(expired, execution)
+			}
+		}
+	}
+
+	sort.Slice(expired, func(i, j int) bool {
+		return expired[i].Id < expired[j].Id
+	})
+
+	return expired, nil
+}
+
+// finishedBefore reports whether the execution finished before the other one, where an unknown finish time is
+// the earliest.
+func finishedBefore(execution Execution, other Execution) bool {
+	finishedAt, otherFinishedAt := execution.FinishedAt(), other.FinishedAt()
+
+	switch {
+	case finishedAt == nil:
+		return otherFinishedAt != nil
+	case otherFinishedAt == nil:
+		return false
+	default:
+		return finishedAt.Before(*otherFinishedAt)
+	}
+}
+
+var (
+	ErrRetentionMaxAgeInvalid = errors.New("retention max age must be a positive duration")
+)
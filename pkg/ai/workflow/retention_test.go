@@ -0,0 +1,76 @@
+package workflow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func retentionTestExecutions(now time.Time) []Execution {
+	finishedAt := func(age time.Duration) *time.Time {
+		at := now.Add(-age)
+		return &at
+	}
+
+	return []Execution{
+		{Id: "release-old", WorkflowId: "release", Status: ExecutionStatusCompleted, CompletedAt: finishedAt(48 * time.Hour)},
+		{Id: "release-new", WorkflowId: "release", Status: ExecutionStatusAborted, AbortedAt: finishedAt(time.Hour)},
+		{Id: "release-legacy", WorkflowId: "release", Status: ExecutionStatusCompleted},
+		{Id: "release-running", WorkflowId: "release", Status: ExecutionStatusRunning},
+		{Id: "hotfix-old", WorkflowId: "hotfix", Status: ExecutionStatusCompleted, CompletedAt: finishedAt(72 * time.Hour)},
+	}
+}
+
+func expiredIds(executions []Execution) []ExecutionId {
+	ids := make([]ExecutionId, 0, len(executions))
+	for _, execution := range executions {
+		ids = append(ids, execution.Id)
+	}
+
+	return ids
+}
+
+func TestRetentionConfig_Expired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 3, 14, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		retention RetentionConfig
+		want      []ExecutionId
+	}{
+		{name: "no limits", retention: RetentionConfig{}, want: []ExecutionId{}},
+		{name: "max age", retention: RetentionConfig{MaxAge: "24h"}, want: []ExecutionId{"hotfix-old", "release-old"}},
+		{name: "max count", retention: RetentionConfig{MaxCount: 1}, want: []ExecutionId{"release-legacy", "release-old"}},
+		{name: "max count keeps newest", retention: RetentionConfig{MaxCount: 2}, want: []ExecutionId{"release-legacy"}},
+		{name: "max age and count", retention: RetentionConfig{MaxAge: "24h", MaxCount: 2}, want: []ExecutionId{"hotfix-old", "release-legacy", "release-old"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			expired, err := tt.retention.Expired(retentionTestExecutions(now), now)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, expiredIds(expired))
+		})
+	}
+}
+
+func TestRetentionConfig_Expired_WhenMaxAgeInvalid_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	for _, maxAge := range []string{"30 days", "-1h", "0s"} {
+		t.Run(maxAge, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := RetentionConfig{MaxAge: maxAge}.Expired(nil, time.Now())
+
+			require.ErrorIs(t, err, ErrRetentionMaxAgeInvalid)
+		})
+	}
+}
@@ -1,5 +1,58 @@
 package workflow
 
+import "errors"
+
+// Service drives workflow executions step by step. Every change is recorded in the execution history together
+// with the actor that made it.
 type Service interface {
-	Execute(workflowId WorkflowId) (ExecutionId, error)
+	// Execute creates a pending execution of the workflow positioned at its first step. When the first step
+	// requires approval, the execution awaits it instead. Whenever an execution enters a step that invokes
+	// another workflow, a child execution of that workflow is started and the execution is suspended until the
+	// child completes; completing the child advances the suspended execution past the step.
+	Execute(actor Actor, workflowId WorkflowId) (ExecutionId, error)
+
+	// GetExecution returns the execution with the given ID.
+	GetExecution(executionId ExecutionId) (*Execution, error)
+
+	// SetState validates a value of a state variable against the schema declared by the workflow, stores it and
+	// marks the execution as running.
+	SetState(actor Actor, executionId ExecutionId, key string, value any) (*Execution, error)
+
+	// Next completes the current step and moves the execution to the step chosen by its transitions. It fails
+	// while any output declared by the current step is missing. Completing a terminal or the last step completes
+	// the execution. Entering a step that requires approval pauses the execution until it is approved.
+	Next(actor Actor, executionId ExecutionId) (*Execution, error)
+
+	// Abort stops the execution before completion, together with the sub-workflow execution it waits for and
+	// the execution it was started by.
+	Abort(actor Actor, executionId ExecutionId) (*Execution, error)
+
+	// Approve lets an execution awaiting approval start working on its current step.
+	Approve(actor Actor, executionId ExecutionId) (*Execution, error)
+
+	// Reject refuses an execution awaiting approval. The execution returns to the previous step with the reason
+	// recorded for the agent, or is aborted when there is no previous step.
+	Reject(actor Actor, executionId ExecutionId, reason string) (*Execution, error)
+
+	// Migrate moves the execution from the workflow version it runs to the current version of the workflow.
+	// stepIds maps step IDs of the old version to step IDs of the current one; unmapped steps keep their ID.
+	// State values the current version no longer declares are dropped, and the values left must match the schemas
+	// of the current version. Suspended executions cannot be migrated, since their current step waits for a
+	// sub-workflow execution.
+	Migrate(actor Actor, executionId ExecutionId, stepIds map[string]string) (*Execution, error)
+
+	// History returns the events of the execution in the order they happened.
+	History(executionId ExecutionId) ([]Event, error)
 }
+
+var (
+	ErrExecutionFinished            = errors.New("execution already finished")
+	ErrExecutionAwaitingApproval    = errors.New("execution awaiting approval")
+	ErrExecutionNotAwaitingApproval = errors.New("execution not awaiting approval")
+	ErrExecutionSuspended           = errors.New("execution suspended until its sub-workflow execution completes")
+	ErrExecutionNotSuspended        = errors.New("execution not suspended for the sub-workflow execution")
+	ErrWorkflowInvocationCycle      = errors.New("workflow invokes itself")
+	ErrExecutionUpToDate            = errors.New("execution already runs the current workflow version")
+	ErrStateNotDeclared             = errors.New("state not declared by workflow")
+	ErrStepNotFound                 = errors.New("step not found")
+)
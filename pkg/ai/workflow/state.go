@@ -0,0 +1,183 @@
+package workflow
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/invopop/jsonschema"
+	jsonschemaValidator "github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// stateSchemaLocation is the location every state schema is compiled under. Each schema is compiled by its
+// own compiler, so the location never clashes.
+const stateSchemaLocation = "state.json"
+
+// Violation describes a single way in which a state value breaks its schema.
+type Violation struct {
+	// Location is the JSON pointer of the offending part of the value, empty for the value itself.
+	Location string `json:"location,omitempty"`
+
+	// Keyword is the JSON pointer of the failing schema keyword, e.g. "/maximum".
+	Keyword string `json:"keyword"`
+
+	// Message explains the violation.
+	Message string `json:"message"`
+}
+
+// StateError is returned when a state value does not match the schema declared for it.
+type StateError struct {
+	Key        string      `json:"key"`
+	Value      any         `json:"value"`
+	Violations []Violation `json:"violations"`
+}
+
+func (err *StateError) Error() string {
+	messages := make([]string, 0, len(err.Violations))
+	for _, violation := range err.Violations {
+		if violation.Location != "" {
+			messages = append(messages, violation.Location+": "+violation.Message)
+			continue
+		}
+
+		messages = append(messages, violation.Message)
+	}
+
+	return fmt.Sprintf("state %s: %s: %s", err.Key, ErrStateInvalid, strings.Join(messages, "; "))
+}
+
+func (err *StateError) Unwrap() error {
+	return ErrStateInvalid
+}
+
+// OutputsError is returned when a step is completed before all of its declared outputs are set.
+type OutputsError struct {
+	StepId  string   `json:"stepId"`
+	Missing []string `json:"missing"`
+}
+
+func (err *OutputsError) Error() string {
+	return fmt.Sprintf("step %s: %s: %s", err.StepId, ErrStepOutputsMissing, strings.Join(err.Missing, ", "))
+}
+
+func (err *OutputsError) Unwrap() error {
+	return ErrStepOutputsMissing
+}
+
+// ValidateState checks a value of the state variable key against the schema the workflow declares for it.
+// A value breaking the schema is reported as a *StateError.
+func (workflow Workflow) ValidateState(key string, value any) error {
+	schema, declared := workflow.State[key]
+	if !declared {
+		return fmt.Errorf("state %s: %w", key, ErrStateNotDeclared)
+	}
+
+	if schema == nil {
+		return nil
+	}
+
+	compiled, err := compileStateSchema(schema)
+	if err != nil {
+		return fmt.Errorf("state %s: %w: %v", key, ErrStateSchemaInvalid, err)
+	}
+
+	instance, err := toJSONValue(value)
+	if err != nil {
+		return fmt.Errorf("state %s: %w: %v", key, ErrStateInvalid, err)
+	}
+
+	err = compiled.Validate(instance)
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *jsonschemaValidator.ValidationError
+	if !errors.As(err, &validationErr) {
+		return fmt.Errorf("state %s: %w: %v", key, ErrStateInvalid, err)
+	}
+
+	return &StateError{
+		Key:        key,
+		Value:      value,
+		Violations: violations(validationErr),
+	}
+}
+
+// ValidateOutputs checks that every output declared by the step is present in the state values. Missing
+// outputs are reported as an *OutputsError.
+func (step Step) ValidateOutputs(stateValues map[string]any) error {
+	var missing []string
+	for _, output := range step.Outputs {
+		if _, found := stateValues[output]; !found {
+			missing = append(missing, output)
+		}
+	}
+
+	if len(missing) > 0 {
+		return &OutputsError{StepId: step.ID, Missing: missing}
+	}
+
+	return nil
+}
+
+func compileStateSchema(schema *jsonschema.Schema) (*jsonschemaValidator.Schema, error) {
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := jsonschemaValidator.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	compiler := jsonschemaValidator.NewCompiler()
+	if err := compiler.AddResource(stateSchemaLocation, document); err != nil {
+		return nil, err
+	}
+
+	return compiler.Compile(stateSchemaLocation)
+}
+
+// toJSONValue converts a value to the form it takes after a JSON round trip, which is what the schema
+// validator expects.
+func toJSONValue(value any) (any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonschemaValidator.UnmarshalJSON(bytes.NewReader(data))
+}
+
+func violations(validationErr *jsonschemaValidator.ValidationError) []Violation {
+	output := validationErr.BasicOutput()
+
+	units := output.Errors
+	if len(units) == 0 {
+		units = []jsonschemaValidator.OutputUnit{*output}
+	}
+
+	var result []Violation
+	for _, unit := range units {
+		if unit.Error == nil {
+			continue
+		}
+
+		result = append(result, Violation{
+			Location: unit.InstanceLocation,
+			Keyword:  unit.KeywordLocation,
+			Message:  unit.Error.String(),
+		})
+	}
+
+	return result
+}
+
+var (
+	ErrStateInvalid       = errors.New("state value does not match its schema")
+	ErrStateSchemaInvalid = errors.New("state schema is invalid")
+	ErrStepOutputsMissing = errors.New("step outputs missing")
+)
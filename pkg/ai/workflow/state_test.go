@@ -0,0 +1,125 @@
+package workflow
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/invopop/jsonschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stateTestWorkflow() Workflow {
+	return Workflow{
+		State: map[string]*jsonschema.Schema{
+			"firstNumber": {Type: "integer", Minimum: json.Number("1"), Maximum: json.Number("100")},
+			"summary":     {Type: "string", MinLength: func() *uint64 { value := uint64(3); return &value }()},
+			"anything":    nil,
+		},
+	}
+}
+
+func TestWorkflow_ValidateState(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		key         string
+		value       any
+		wantErr     error
+		wantKeyword string
+	}{
+		{name: "integer within range", key: "firstNumber", value: 42},
+		{name: "float decoded from JSON", key: "firstNumber", value: float64(100)},
+		{name: "value below minimum", key: "firstNumber", value: 0, wantErr: ErrStateInvalid, wantKeyword: "/minimum"},
+		{name: "value above maximum", key: "firstNumber", value: 101, wantErr: ErrStateInvalid, wantKeyword: "/maximum"},
+		{name: "wrong type", key: "firstNumber", value: "forty two", wantErr: ErrStateInvalid, wantKeyword: "/type"},
+		{name: "fractional integer", key: "firstNumber", value: 4.5, wantErr: ErrStateInvalid, wantKeyword: "/type"},
+		{name: "string too short", key: "summary", value: "ok", wantErr: ErrStateInvalid, wantKeyword: "/minLength"},
+		{name: "state without schema", key: "anything", value: map[string]any{"free": true}},
+		{name: "undeclared state", key: "unknown", value: 1, wantErr: ErrStateNotDeclared},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := stateTestWorkflow().ValidateState(tt.key, tt.value)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantKeyword == "" {
+				return
+			}
+
+			var stateErr *StateError
+			require.ErrorAs(t, err, &stateErr)
+			assert.Equal(t, tt.key, stateErr.Key)
+			assert.Equal(t, tt.value, stateErr.Value)
+			require.NotEmpty(t, stateErr.Violations)
+			assert.Equal(t, tt.wantKeyword, stateErr.Violations[0].Keyword)
+			assert.NotEmpty(t, stateErr.Violations[0].Message)
+		})
+	}
+}
+
+func TestWorkflow_ValidateState_WhenSchemaInvalid_ThenReturnsError(t *testing.T) {
+	t.Parallel()
+
+	workflow := Workflow{State: map[string]*jsonschema.Schema{"name": {Type: "string", Pattern: "("}}}
+
+	err := workflow.ValidateState("name", "value")
+
+	require.ErrorIs(t, err, ErrStateSchemaInvalid)
+}
+
+func TestStateError_Error_ThenListsViolations(t *testing.T) {
+	t.Parallel()
+
+	err := &StateError{
+		Key: "config",
+		Violations: []Violation{
+			{Message: "missing property 'name'"},
+			{Location: "/port", Message: "must be >= 1"},
+		},
+	}
+
+	assert.Equal(t, "state config: state value does not match its schema: missing property 'name'; /port: must be >= 1", err.Error())
+}
+
+func TestStep_ValidateOutputs(t *testing.T) {
+	t.Parallel()
+
+	step := Step{ID: "prepare", Outputs: []string{"firstNumber", "secondNumber"}}
+
+	t.Run("all outputs set", func(t *testing.T) {
+		t.Parallel()
+
+		err := step.ValidateOutputs(map[string]any{"firstNumber": 1, "secondNumber": 2})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("outputs missing", func(t *testing.T) {
+		t.Parallel()
+
+		err := step.ValidateOutputs(map[string]any{"firstNumber": 1})
+
+		require.ErrorIs(t, err, ErrStepOutputsMissing)
+		var outputsErr *OutputsError
+		require.ErrorAs(t, err, &outputsErr)
+		assert.Equal(t, "prepare", outputsErr.StepId)
+		assert.Equal(t, []string{"secondNumber"}, outputsErr.Missing)
+		assert.Equal(t, "step prepare: step outputs missing: secondNumber", err.Error())
+	})
+
+	t.Run("no outputs declared", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, Step{ID: "free"}.ValidateOutputs(nil))
+	})
+}
@@ -1,7 +1,11 @@
 package workflow
 
 import (
+	"errors"
+	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -26,5 +30,214 @@ func Validate(w Workflow) error {
 	if err := v.Struct(w); err != nil {
 		return err
 	}
+
+	for key, schema := range w.State {
+		if schema == nil {
+			continue
+		}
+		if _, err := compileStateSchema(schema); err != nil {
+			return fmt.Errorf("state %s: %w: %v", key, ErrStateSchemaInvalid, err)
+		}
+	}
+
+	for _, step := range w.Steps {
+		for _, output := range step.Outputs {
+			if _, declared := w.State[output]; !declared {
+				return fmt.Errorf("step %s output %s: %w", step.ID, output, ErrStateNotDeclared)
+			}
+		}
+	}
+
+	if err := validateInvocations(w); err != nil {
+		return err
+	}
+
+	if err := validateTransitions(w); err != nil {
+		return err
+	}
+
+	return validateReachability(w)
+}
+
+// validateInvocations checks that steps invoking another workflow do not invoke this one and map its state only
+// to state variables declared here. The state of the invoked workflow is checked when it is started.
+func validateInvocations(w Workflow) error {
+	for _, step := range w.Steps {
+		if step.Workflow == nil {
+			continue
+		}
+
+		if err := step.Workflow.WorkflowId.Validate(); err != nil {
+			return fmt.Errorf("step %s: %w", step.ID, err)
+		}
+
+		if step.Workflow.WorkflowId == w.Metadata.ID {
+			return fmt.Errorf("step %s: %w", step.ID, ErrWorkflowInvocationCycle)
+		}
+
+		for _, variable := range step.Workflow.Inputs {
+			if _, declared := w.State[variable]; !declared {
+				return fmt.Errorf("step %s input from %s: %w", step.ID, variable, ErrStateNotDeclared)
+			}
+		}
+
+		for variable := range step.Workflow.Outputs {
+			if _, declared := w.State[variable]; !declared {
+				return fmt.Errorf("step %s output to %s: %w", step.ID, variable, ErrStateNotDeclared)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateInvocations checks that every workflow invoked by a step of the given workflows is among them and that
+// no workflow ends up invoking itself through other workflows, which would only fail in the middle of an execution.
+func ValidateInvocations(workflows []Workflow) error {
+	ids := make(map[WorkflowId]bool, len(workflows))
+	for _, workflow := range workflows {
+		ids[workflow.Metadata.ID] = true
+	}
+
+	invoked := make(map[WorkflowId][]WorkflowId, len(workflows))
+	for _, workflow := range workflows {
+		for _, step := range workflow.Steps {
+			if step.Workflow == nil {
+				continue
+			}
+
+			if !ids[step.Workflow.WorkflowId] {
+				return fmt.Errorf("workflow %s step %s invokes %s: %w", workflow.Metadata.ID, step.ID, step.Workflow.WorkflowId, ErrWorkflowNotFound)
+			}
+
+			invoked[workflow.Metadata.ID] = append(invoked[workflow.Metadata.ID], step.Workflow.WorkflowId)
+		}
+	}
+
+	checked := make(map[WorkflowId]bool, len(workflows))
+	for _, workflow := range workflows {
+		if err := checkInvocationCycle(workflow.Metadata.ID, invoked, checked, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkInvocationCycle walks the invocations depth first from the workflow and returns an error naming the cycle
+// when a workflow on the current path is invoked again.
+func checkInvocationCycle(id WorkflowId, invoked map[WorkflowId][]WorkflowId, checked map[WorkflowId]bool, path []WorkflowId) error {
+	if index := slices.Index(path, id); index >= 0 {
+		cycle := make([]string, 0, len(path)-index+1)
+		for _, member := range append(path[index:], id) {
+			cycle = append(cycle, string(member))
+		}
+
+		return fmt.Errorf("workflow %s: %w: %s", id, ErrWorkflowInvocationCycle, strings.Join(cycle, " -> "))
+	}
+
+	if checked[id] {
+		return nil
+	}
+
+	path = append(path, id)
+	for _, next := range invoked[id] {
+		if err := checkInvocationCycle(next, invoked, checked, path); err != nil {
+			return err
+		}
+	}
+	checked[id] = true
+
+	return nil
+}
+
+// validateTransitions checks that step IDs are unique and that every transition points at an existing step
+// and has a condition over declared state variables.
+func validateTransitions(w Workflow) error {
+	stepIds := make(map[string]bool, len(w.Steps))
+	for _, step := range w.Steps {
+		if stepIds[step.ID] {
+			return fmt.Errorf("step %s: %w", step.ID, ErrStepDuplicated)
+		}
+		stepIds[step.ID] = true
+	}
+
+	for _, step := range w.Steps {
+		for _, transition := range step.Transitions {
+			if !stepIds[transition.Next] {
+				return fmt.Errorf("step %s transition to %s: %w", step.ID, transition.Next, ErrStepNotFound)
+			}
+
+			if transition.When == "" {
+				continue
+			}
+
+			condition, err := ParseCondition(transition.When)
+			if err != nil {
+				return fmt.Errorf("step %s: %w", step.ID, err)
+			}
+
+			for _, variable := range condition.Variables() {
+				if _, declared := w.State[variable]; !declared {
+					return fmt.Errorf("step %s condition %q variable %s: %w", step.ID, transition.When, variable, ErrStateNotDeclared)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateReachability checks that every step can be reached from the first one.
+func validateReachability(w Workflow) error {
+	reachable := make([]bool, len(w.Steps))
+	reachable[0] = true
+
+	queue := []int{0}
+	for len(queue) > 0 {
+		index := queue[0]
+		queue = queue[1:]
+
+		for _, successor := range successors(w, index) {
+			if !reachable[successor] {
+				reachable[successor] = true
+				queue = append(queue, successor)
+			}
+		}
+	}
+
+	for index, step := range w.Steps {
+		if !reachable[index] {
+			return fmt.Errorf("step %s: %w", step.ID, ErrStepUnreachable)
+		}
+	}
+
 	return nil
 }
+
+func successors(w Workflow, index int) []int {
+	step := w.Steps[index]
+
+	var result []int
+	fallsThrough := !step.Terminal && index < len(w.Steps)-1
+	for _, transition := range step.Transitions {
+		if next, found := w.StepIndex(transition.Next); found {
+			result = append(result, next)
+		}
+		if transition.When == "" {
+			fallsThrough = false
+			break
+		}
+	}
+
+	if fallsThrough {
+		result = append(result, index+1)
+	}
+
+	return result
+}
+
+var (
+	ErrStepDuplicated  = errors.New("step defined more than once")
+	ErrStepUnreachable = errors.New("step is not reachable from the first step")
+)
@@ -3,6 +3,7 @@ package workflow
 import (
 	"testing"
 
+	"github.com/invopop/jsonschema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -165,6 +166,76 @@ func TestValidate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "step output not declared in state",
+			workflow: Workflow{
+				Metadata: Metadata{
+					ID:          WorkflowId("test-workflow"),
+					Name:        "Test Workflow",
+					Description: "A test workflow",
+					Version:     "1.0.0",
+				},
+				Steps: []Step{
+					{
+						ID:           "step1",
+						Name:         "Step 1",
+						Description:  "First step",
+						Instructions: []string{"Do something"},
+						Outputs:      []string{"result"},
+					},
+				},
+			},
+			wantErr:    true,
+			errContain: "step step1 output result: state not declared by workflow",
+		},
+		{
+			name: "invalid state schema",
+			workflow: Workflow{
+				Metadata: Metadata{
+					ID:          WorkflowId("test-workflow"),
+					Name:        "Test Workflow",
+					Description: "A test workflow",
+					Version:     "1.0.0",
+				},
+				State: map[string]*jsonschema.Schema{
+					"result": {Type: "number", Pattern: "("},
+				},
+				Steps: []Step{
+					{
+						ID:           "step1",
+						Name:         "Step 1",
+						Description:  "First step",
+						Instructions: []string{"Do something"},
+					},
+				},
+			},
+			wantErr:    true,
+			errContain: "state result: state schema is invalid",
+		},
+		{
+			name: "declared step outputs",
+			workflow: Workflow{
+				Metadata: Metadata{
+					ID:          WorkflowId("test-workflow"),
+					Name:        "Test Workflow",
+					Description: "A test workflow",
+					Version:     "1.0.0",
+				},
+				State: map[string]*jsonschema.Schema{
+					"result": {Type: "integer"},
+				},
+				Steps: []Step{
+					{
+						ID:           "step1",
+						Name:         "Step 1",
+						Description:  "First step",
+						Instructions: []string{"Do something"},
+						Outputs:      []string{"result"},
+					},
+				},
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -271,3 +342,253 @@ func TestValidateSemver(t *testing.T) {
 		})
 	}
 }
+
+func branchingTestWorkflow(steps ...Step) Workflow {
+	for index := range steps {
+		steps[index].Name = steps[index].ID
+		steps[index].Description = steps[index].ID
+		steps[index].Instructions = []string{"Do " + steps[index].ID}
+	}
+
+	return Workflow{
+		Metadata: Metadata{
+			ID:          WorkflowId("review"),
+			Name:        "Review",
+			Description: "A review workflow",
+			Version:     "1.0.0",
+		},
+		State: map[string]*jsonschema.Schema{
+			"testsPassed": {Type: "boolean"},
+		},
+		Steps: steps,
+	}
+}
+
+func TestValidate_Transitions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		workflow Workflow
+		wantErr  error
+	}{
+		{
+			name: "loop back to fix step",
+			workflow: branchingTestWorkflow(
+				Step{ID: "fix"},
+				Step{ID: "test", Transitions: []Transition{{When: "testsPassed == false", Next: "fix"}}},
+				Step{ID: "release", Terminal: true},
+			),
+		},
+		{
+			name: "branch to steps after terminal step",
+			workflow: branchingTestWorkflow(
+				Step{ID: "test", Transitions: []Transition{{When: "testsPassed == false", Next: "report"}}},
+				Step{ID: "release", Terminal: true},
+				Step{ID: "report", Terminal: true},
+			),
+		},
+		{
+			name: "duplicated step",
+			workflow: branchingTestWorkflow(
+				Step{ID: "test"},
+				Step{ID: "test"},
+			),
+			wantErr: ErrStepDuplicated,
+		},
+		{
+			name: "transition to unknown step",
+			workflow: branchingTestWorkflow(
+				Step{ID: "test", Transitions: []Transition{{When: "testsPassed == false", Next: "fix"}}},
+			),
+			wantErr: ErrStepNotFound,
+		},
+		{
+			name: "invalid condition",
+			workflow: branchingTestWorkflow(
+				Step{ID: "test", Transitions: []Transition{{When: "testsPassed ==", Next: "test"}}},
+			),
+			wantErr: ErrConditionInvalid,
+		},
+		{
+			name: "condition over undeclared state",
+			workflow: branchingTestWorkflow(
+				Step{ID: "test", Transitions: []Transition{{When: "coverage < 80", Next: "test"}}},
+			),
+			wantErr: ErrStateNotDeclared,
+		},
+		{
+			name: "step after terminal step is unreachable",
+			workflow: branchingTestWorkflow(
+				Step{ID: "test", Terminal: true},
+				Step{ID: "release"},
+			),
+			wantErr: ErrStepUnreachable,
+		},
+		{
+			name: "step skipped by unconditional transition is unreachable",
+			workflow: branchingTestWorkflow(
+				Step{ID: "test", Transitions: []Transition{{Next: "release"}}},
+				Step{ID: "fix"},
+				Step{ID: "release"},
+			),
+			wantErr: ErrStepUnreachable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Validate(tt.workflow)
+
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestValidate_Invocations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		workflow   func() Workflow
+		wantErr    error
+		wantAnyErr bool
+	}{
+		{
+			name: "step invoking another workflow without instructions",
+			workflow: func() Workflow {
+				workflow := branchingTestWorkflow(Step{ID: "changelog"})
+				workflow.Steps[0].Instructions = nil
+				workflow.Steps[0].Workflow = &Invocation{
+					WorkflowId: "changelog",
+					Inputs:     map[string]string{"passed": "testsPassed"},
+					Outputs:    map[string]string{"testsPassed": "passed"},
+				}
+				return workflow
+			},
+		},
+		{
+			name: "step without instructions and invocation",
+			workflow: func() Workflow {
+				workflow := branchingTestWorkflow(Step{ID: "changelog"})
+				workflow.Steps[0].Instructions = nil
+				return workflow
+			},
+			wantAnyErr: true,
+		},
+		{
+			name: "invocation of the workflow itself",
+			workflow: func() Workflow {
+				workflow := branchingTestWorkflow(Step{ID: "again"})
+				workflow.Steps[0].Workflow = &Invocation{WorkflowId: "review"}
+				return workflow
+			},
+			wantErr: ErrWorkflowInvocationCycle,
+		},
+		{
+			name: "invocation of invalid workflow id",
+			workflow: func() Workflow {
+				workflow := branchingTestWorkflow(Step{ID: "changelog"})
+				workflow.Steps[0].Workflow = &Invocation{WorkflowId: "change log"}
+				return workflow
+			},
+			wantErr: ErrWorkflowInvalidID,
+		},
+		{
+			name: "input from undeclared state",
+			workflow: func() Workflow {
+				workflow := branchingTestWorkflow(Step{ID: "changelog"})
+				workflow.Steps[0].Workflow = &Invocation{WorkflowId: "changelog", Inputs: map[string]string{"version": "version"}}
+				return workflow
+			},
+			wantErr: ErrStateNotDeclared,
+		},
+		{
+			name: "output to undeclared state",
+			workflow: func() Workflow {
+				workflow := branchingTestWorkflow(Step{ID: "changelog"})
+				workflow.Steps[0].Workflow = &Invocation{WorkflowId: "changelog", Outputs: map[string]string{"entry": "entry"}}
+				return workflow
+			},
+			wantErr: ErrStateNotDeclared,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Validate(tt.workflow())
+
+			switch {
+			case tt.wantErr != nil:
+				require.ErrorIs(t, err, tt.wantErr)
+			case tt.wantAnyErr:
+				require.Error(t, err)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateInvocations_WhenInvokedWorkflowMissing_ThenReturnsNotFoundError(t *testing.T) {
+	t.Parallel()
+
+	release := branchingTestWorkflow(Step{ID: "changelog"})
+	release.Metadata.ID = "release"
+	release.Steps[0].Workflow = &Invocation{WorkflowId: "changelog"}
+
+	require.ErrorIs(t, ValidateInvocations([]Workflow{release}), ErrWorkflowNotFound)
+
+	changelog := branchingTestWorkflow(Step{ID: "write"})
+	changelog.Metadata.ID = "changelog"
+
+	require.NoError(t, ValidateInvocations([]Workflow{release, changelog}))
+}
+
+func TestValidateInvocations_WhenWorkflowsInvokeEachOther_ThenReturnsCycleError(t *testing.T) {
+	t.Parallel()
+
+	release := branchingTestWorkflow(Step{ID: "changelog"})
+	release.Metadata.ID = "release"
+	release.Steps[0].Workflow = &Invocation{WorkflowId: "changelog"}
+
+	changelog := branchingTestWorkflow(Step{ID: "publish"})
+	changelog.Metadata.ID = "changelog"
+	changelog.Steps[0].Workflow = &Invocation{WorkflowId: "publish"}
+
+	publish := branchingTestWorkflow(Step{ID: "release"})
+	publish.Metadata.ID = "publish"
+	publish.Steps[0].Workflow = &Invocation{WorkflowId: "release"}
+
+	err := ValidateInvocations([]Workflow{release, changelog, publish})
+
+	require.ErrorIs(t, err, ErrWorkflowInvocationCycle)
+	assert.Contains(t, err.Error(), "release -> changelog -> publish -> release")
+}
+
+func TestValidateInvocations_WhenWorkflowInvokedFromSeveralWorkflows_ThenReturnsNil(t *testing.T) {
+	t.Parallel()
+
+	changelog := branchingTestWorkflow(Step{ID: "write"})
+	changelog.Metadata.ID = "changelog"
+
+	release := branchingTestWorkflow(Step{ID: "changelog"})
+	release.Metadata.ID = "release"
+	release.Steps[0].Workflow = &Invocation{WorkflowId: "changelog"}
+
+	hotfix := branchingTestWorkflow(Step{ID: "release"}, Step{ID: "changelog"})
+	hotfix.Metadata.ID = "hotfix"
+	hotfix.Steps[0].Workflow = &Invocation{WorkflowId: "release"}
+	hotfix.Steps[1].Workflow = &Invocation{WorkflowId: "changelog"}
+
+	require.NoError(t, ValidateInvocations([]Workflow{hotfix, release, changelog}))
+}
@@ -0,0 +1,55 @@
+package conflict
+
+// Policy decides which artifact is kept when several sources provide one under the same key.
+type Policy string
+
+const (
+	// PolicyError fails the update on the first conflict.
+	PolicyError Policy = "error"
+
+	// PolicyFirstWins keeps the artifact from the source listed first.
+	PolicyFirstWins Policy = "first-wins"
+
+	// PolicyLastWins keeps the artifact from the source listed last.
+	PolicyLastWins Policy = "last-wins"
+)
+
+// DefaultPolicy is applied to every kind of artifact when the project configuration does not set a policy.
+const DefaultPolicy = PolicyError
+
+// Kind identifies the type of artifact a conflict is about.
+type Kind string
+
+const (
+	KindInstruction Kind = "instruction"
+	KindSkill       Kind = "skill"
+	KindWorkflow    Kind = "workflow"
+	KindMCPServer   Kind = "mcp"
+	KindStandard    Kind = "standard"
+	KindTool        Kind = "tool"
+)
+
+// Override pins the source that provides a single artifact regardless of the policy. For instructions the
+// name is a category and the overriding source replaces every other source of that category.
+type Override struct {
+	Kind      Kind   `json:"kind" validate:"required,oneof=instruction skill workflow mcp standard tool"`
+	Name      string `json:"name" validate:"required"`
+	SourceURI string `json:"sourceUri" validate:"required"`
+}
+
+// Config defines how conflicts between artifacts from different sources are resolved.
+type Config struct {
+	Policy    Policy     `json:"policy,omitempty" validate:"omitempty,oneof=error first-wins last-wins"`
+	Overrides []Override `json:"overrides,omitempty" validate:"omitempty,dive"`
+}
+
+// FindOverride returns the override registered for the artifact, if any.
+func (config Config) FindOverride(kind Kind, name string) (Override, bool) {
+	for _, override := range config.Overrides {
+		if override.Kind == kind && override.Name == name {
+			return override, true
+		}
+	}
+
+	return Override{}, false
+}
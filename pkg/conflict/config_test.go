@@ -0,0 +1,41 @@
+package conflict
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_FindOverride(t *testing.T) {
+	t.Parallel()
+
+	config := Config{
+		Overrides: []Override{
+			{Kind: KindSkill, Name: "git-commit", SourceURI: "local://./skills"},
+			{Kind: KindWorkflow, Name: "git-commit", SourceURI: "local://./workflows"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		kind      Kind
+		key       string
+		wantFound bool
+		wantUri   string
+	}{
+		{name: "WhenKindAndNameMatch_ThenReturnsOverride", kind: KindWorkflow, key: "git-commit", wantFound: true, wantUri: "local://./workflows"},
+		{name: "WhenNameMatchesOtherKind_ThenNotFound", kind: KindStandard, key: "git-commit"},
+		{name: "WhenNameDiffers_ThenNotFound", kind: KindSkill, key: "review"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			override, found := config.FindOverride(tt.kind, tt.key)
+
+			assert.Equal(t, tt.wantFound, found)
+			assert.Equal(t, tt.wantUri, override.SourceURI)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package conflict
+
+import (
+	"errors"
+
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+)
+
+// Shadow records an artifact that was dropped in favour of another one with the same key.
+type Shadow struct {
+	Kind Kind
+	Name string
+
+	// Winner is the provenance of the artifact that was kept.
+	Winner sourceAPI.Provenance
+
+	// Shadowed is the provenance of the artifact that was dropped.
+	Shadowed sourceAPI.Provenance
+}
+
+// ErrConflict is returned by the error policy when several sources provide the same artifact.
+var ErrConflict = errors.New("conflict")
+
+// ErrOverrideSourceNotFound is returned when an override points at a source that does not provide the artifact.
+var ErrOverrideSourceNotFound = errors.New("override source not found")
@@ -1,7 +1,11 @@
 package standard
 
+import sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+
 type SourceConfig struct {
 	URI string `json:"uri" validate:"required,uri"`
+
+	sourceAPI.Filter
 }
 
 type RenderConfig struct {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path/filepath"
 
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
 	"github.com/spf13/afero"
 	"sigs.k8s.io/yaml"
 )
@@ -38,6 +39,8 @@ func (loader *Loader) Load() ([]Standard, error) {
 			return nil, fmt.Errorf("validate standard: %s: %w", filePath, err)
 		}
 
+		standard.Provenance = &sourceAPI.Provenance{Path: filePath}
+
 		standards = append(standards, *standard)
 	}
 
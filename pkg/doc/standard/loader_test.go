@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -298,6 +299,7 @@ examples:
 		wantAnyErr bool
 		errContain string
 		checkName  string
+		checkPath  string
 	}{
 		{
 			name: "single valid standard yaml",
@@ -307,6 +309,7 @@ examples:
 			wantLen:   1,
 			wantErr:   nil,
 			checkName: "Integration Test Standard",
+			checkPath: "standard.yaml",
 		},
 		{
 			name: "multiple valid standards",
@@ -384,6 +387,9 @@ examples:
 				if tt.checkName != "" && len(got) > 0 {
 					assert.Equal(t, tt.checkName, got[0].Metadata.Name)
 				}
+				if tt.checkPath != "" && len(got) > 0 {
+					assert.Equal(t, &sourceAPI.Provenance{Path: tt.checkPath}, got[0].Provenance)
+				}
 			}
 		})
 	}
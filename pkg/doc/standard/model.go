@@ -1,5 +1,7 @@
 package standard
 
+import sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+
 type StandardId string
 
 type ScopeMetadata struct {
@@ -106,4 +108,6 @@ type Standard struct {
 	GoldenPath    *GoldenPath    `json:"goldenPath,omitempty" validate:"omitempty"`
 	Examples      Examples       `json:"examples" validate:"required"`
 	References    []Reference    `json:"references,omitempty" validate:"omitempty,dive"`
+
+	Provenance *sourceAPI.Provenance `json:"provenance,omitempty"`
 }
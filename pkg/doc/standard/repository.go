@@ -2,7 +2,10 @@ package standard
 
 import "errors"
 
-var ErrStandardInvalidID = errors.New("standard id must be in kebab-case format")
+var (
+	ErrStandardInvalidID = errors.New("standard id must be in kebab-case format")
+	ErrStandardNotFound  = errors.New("standard not found")
+)
 
 func (id StandardId) Validate() error {
 	if !kebabCaseRegex.MatchString(string(id)) {
@@ -1,7 +1,12 @@
 package rulebook
 
+import sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
+
 type SourceConfig struct {
 	URI string `json:"uri"`
+
+	// Filter applies to the artifacts of this rulebook only, not to the rulebooks it depends on.
+	sourceAPI.Filter
 }
 
 // Config defines the rulebook configuration.
@@ -0,0 +1,32 @@
+package rulebook
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+)
+
+// SatisfiedBy reports whether the given rulebook version matches the dependency version constraint.
+func (dependency Dependency) SatisfiedBy(version string) (bool, error) {
+	constraint, err := semver.NewConstraint(dependency.Version)
+	if err != nil {
+		return false, fmt.Errorf("dependency %s version constraint: %w", dependency.URI, err)
+	}
+
+	parsed, err := semver.NewVersion(version)
+	if err != nil {
+		return false, fmt.Errorf("rulebook version %s: %w", version, err)
+	}
+
+	return constraint.Check(parsed), nil
+}
+
+// ErrDependencyCycle is returned when rulebook dependencies form a cycle.
+var ErrDependencyCycle = errors.New("dependency cycle")
+
+// ErrIncompatibleVersion is returned when a resolved rulebook version does not satisfy a dependency constraint.
+var ErrIncompatibleVersion = errors.New("incompatible version")
+
+// ErrDependencyOutsideSource is returned when a relative dependency points outside the source of its dependent.
+var ErrDependencyOutsideSource = errors.New("dependency outside source")
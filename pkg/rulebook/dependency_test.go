@@ -0,0 +1,70 @@
+package rulebook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependency_SatisfiedBy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		constraint    string
+		version       string
+		wantSatisfied bool
+		wantErr       bool
+	}{
+		{
+			name:          "WhenVersionMatchesCaret_ThenSatisfied",
+			constraint:    "^1.2.0",
+			version:       "1.4.1",
+			wantSatisfied: true,
+		},
+		{
+			name:          "WhenMajorVersionDiffers_ThenNotSatisfied",
+			constraint:    "^1.2.0",
+			version:       "2.0.0",
+			wantSatisfied: false,
+		},
+		{
+			name:          "WhenVersionInRange_ThenSatisfied",
+			constraint:    ">=0.1.0, <0.3.0",
+			version:       "0.2.5",
+			wantSatisfied: true,
+		},
+		{
+			name:       "WhenConstraintInvalid_ThenReturnsError",
+			constraint: "not a constraint",
+			version:    "1.0.0",
+			wantErr:    true,
+		},
+		{
+			name:       "WhenVersionInvalid_ThenReturnsError",
+			constraint: "^1.0.0",
+			version:    "latest",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dependency := Dependency{URI: "local://rulebooks/general", Version: tt.constraint}
+
+			satisfied, err := dependency.SatisfiedBy(tt.version)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.False(t, satisfied)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSatisfied, satisfied)
+		})
+	}
+}
@@ -4,14 +4,18 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path"
 	"strings"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/go-playground/validator/v10"
 	instructionAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	mcpAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
 	skillAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	toolAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
 	workflowAPI "github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
 	standardAPI "github.com/orbiqd/orbiqd-projectkit/pkg/doc/standard"
+	sourceAPI "github.com/orbiqd/orbiqd-projectkit/pkg/source"
 	"github.com/spf13/afero"
 	"sigs.k8s.io/yaml"
 )
@@ -40,11 +44,20 @@ func (loader *Loader) Load() (*Rulebook, error) {
 	}
 
 	rulebook := Rulebook{
+		Name:        metadata.Name,
+		Version:     metadata.Version,
+		Description: metadata.Description,
+		Maintainers: metadata.Maintainers,
+		License:     metadata.License,
+
+		Dependencies: metadata.Dependencies,
+
 		AI: AiRulebook{
 			Instructions: []instructionAPI.Instructions{},
 			Skills:       []skillAPI.Skill{},
 			Workflows:    []workflowAPI.Workflow{},
 			MCPServers:   []mcpAPI.MCPServer{},
+			Tools:        []toolAPI.Tool{},
 		},
 		Doc: DocRulebook{
 			Standards: []standardAPI.Standard{},
@@ -65,6 +78,13 @@ func (loader *Loader) Load() (*Rulebook, error) {
 				return nil, fmt.Errorf("ai instructions: load ai instructions: %w", err)
 			}
 
+			for index := range aiInstructions {
+				for provenanceIndex := range aiInstructions[index].Provenance {
+					provenance := &aiInstructions[index].Provenance[provenanceIndex]
+					*provenance = *loader.provenance(provenance, aiInstructionsPath, *metadata)
+				}
+			}
+
 			rulebook.AI.Instructions = append(rulebook.AI.Instructions, aiInstructions...)
 		}
 	}
@@ -83,6 +103,10 @@ func (loader *Loader) Load() (*Rulebook, error) {
 				return nil, fmt.Errorf("ai skills: load ai skills: %w", err)
 			}
 
+			for index := range aiSkills {
+				aiSkills[index].Provenance = loader.provenance(aiSkills[index].Provenance, aiSkillsPath, *metadata)
+			}
+
 			rulebook.AI.Skills = append(rulebook.AI.Skills, aiSkills...)
 		}
 	}
@@ -101,6 +125,10 @@ func (loader *Loader) Load() (*Rulebook, error) {
 				return nil, fmt.Errorf("ai workflows: load ai workflows: %w", err)
 			}
 
+			for index := range aiWorkflows {
+				aiWorkflows[index].Provenance = loader.provenance(aiWorkflows[index].Provenance, aiWorkflowsPath, *metadata)
+			}
+
 			rulebook.AI.Workflows = append(rulebook.AI.Workflows, aiWorkflows...)
 		}
 	}
@@ -119,10 +147,36 @@ func (loader *Loader) Load() (*Rulebook, error) {
 				return nil, fmt.Errorf("ai mcp: load ai mcp servers: %w", err)
 			}
 
+			for index := range aiMCPServers {
+				aiMCPServers[index].Provenance = loader.provenance(aiMCPServers[index].Provenance, aiMCPPath, *metadata)
+			}
+
 			rulebook.AI.MCPServers = append(rulebook.AI.MCPServers, aiMCPServers...)
 		}
 	}
 
+	if metadata.AI != nil && metadata.AI.Tool != nil {
+		for _, aiToolSource := range metadata.AI.Tool.Sources {
+			aiToolPath, err := loader.resolveSourceUri(aiToolSource.URI)
+			if err != nil {
+				return nil, fmt.Errorf("ai tools: resolve source path: %w", err)
+			}
+
+			aiTools, err := toolAPI.NewLoader(
+				afero.NewBasePathFs(loader.fs, aiToolPath),
+			).Load()
+			if err != nil {
+				return nil, fmt.Errorf("ai tools: load ai tools: %w", err)
+			}
+
+			for index := range aiTools {
+				aiTools[index].Provenance = loader.provenance(aiTools[index].Provenance, aiToolPath, *metadata)
+			}
+
+			rulebook.AI.Tools = append(rulebook.AI.Tools, aiTools...)
+		}
+	}
+
 	if metadata.Doc != nil && metadata.Doc.Standard != nil {
 		for _, docStandardSource := range metadata.Doc.Standard.Sources {
 			docStandardPath, err := loader.resolveSourceUri(docStandardSource.URI)
@@ -137,6 +191,10 @@ func (loader *Loader) Load() (*Rulebook, error) {
 				return nil, fmt.Errorf("doc standards: load doc standards: %w", err)
 			}
 
+			for index := range docStandards {
+				docStandards[index].Provenance = loader.provenance(docStandards[index].Provenance, docStandardPath, *metadata)
+			}
+
 			rulebook.Doc.Standards = append(rulebook.Doc.Standards, docStandards...)
 		}
 	}
@@ -166,9 +224,31 @@ func (loader *Loader) validateMetadata(metadata Metadata) error {
 	if err := validate.Struct(metadata); err != nil {
 		return fmt.Errorf("%w: %v", ErrValidationFailed, err)
 	}
+
+	for _, dependency := range metadata.Dependencies {
+		if _, err := semver.NewConstraint(dependency.Version); err != nil {
+			return fmt.Errorf("%w: dependency %s version constraint: %v", ErrValidationFailed, dependency.URI, err)
+		}
+	}
+
 	return nil
 }
 
+// provenance attributes an artifact loaded from sourcePath to this rulebook, making its path relative
+// to the rulebook root.
+func (loader *Loader) provenance(provenance *sourceAPI.Provenance, sourcePath string, metadata Metadata) *sourceAPI.Provenance {
+	result := sourceAPI.Provenance{}
+	if provenance != nil {
+		result = *provenance
+	}
+
+	result.Path = path.Join(strings.TrimPrefix(sourcePath, "/"), result.Path)
+	result.RulebookName = metadata.Name
+	result.RulebookVersion = metadata.Version
+
+	return &result
+}
+
 func (loader *Loader) resolveSourceUri(uri string) (string, error) {
 	path, found := strings.CutPrefix(uri, "rulebook://")
 	if !found {
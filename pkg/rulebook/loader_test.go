@@ -11,6 +11,7 @@ import (
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/doc"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/doc/standard"
+	"github.com/orbiqd/orbiqd-projectkit/pkg/source"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,6 +30,24 @@ func fsWithOpenError(t *testing.T, base afero.Fs, shouldError func(name string)
 	})
 }
 
+const testRulebookIdentity = `name: test-rulebook
+version: 1.2.3
+description: Rulebook used in tests
+maintainers:
+  - name: Platform Team
+    email: platform@example.com
+license: MIT
+`
+
+func withIdentity(metadata Metadata) Metadata {
+	metadata.Name = "test-rulebook"
+	metadata.Version = "1.2.3"
+	metadata.Description = "Rulebook used in tests"
+	metadata.Maintainers = []Maintainer{{Name: "Platform Team", Email: "platform@example.com"}}
+	metadata.License = "MIT"
+	return metadata
+}
+
 func TestLoader_loadMetadata(t *testing.T) {
 	t.Parallel()
 
@@ -251,7 +270,7 @@ func TestLoader_validateMetadata(t *testing.T) {
 	}{
 		{
 			name: "valid full config",
-			metadata: Metadata{
+			metadata: withIdentity(Metadata{
 				AI: &ai.Config{
 					Instruction: &instruction.Config{
 						Sources: []instruction.SourceConfig{
@@ -269,12 +288,12 @@ func TestLoader_validateMetadata(t *testing.T) {
 						},
 					},
 				},
-			},
+			}),
 			wantErr: nil,
 		},
 		{
 			name: "valid partial config",
-			metadata: Metadata{
+			metadata: withIdentity(Metadata{
 				AI: &ai.Config{
 					Skill: &skill.Config{
 						Sources: []skill.SourceConfig{
@@ -282,24 +301,119 @@ func TestLoader_validateMetadata(t *testing.T) {
 						},
 					},
 				},
-			},
+			}),
 			wantErr: nil,
 		},
 		{
 			name: "nil AI field",
-			metadata: Metadata{
+			metadata: withIdentity(Metadata{
 				AI: nil,
-			},
+			}),
 			wantErr: nil,
 		},
+		{
+			name:     "identity only",
+			metadata: withIdentity(Metadata{}),
+			wantErr:  nil,
+		},
 		{
 			name:     "empty Metadata",
 			metadata: Metadata{},
-			wantErr:  nil,
+			wantErr:  ErrValidationFailed,
+		},
+		{
+			name: "missing name",
+			metadata: func() Metadata {
+				metadata := withIdentity(Metadata{})
+				metadata.Name = ""
+				return metadata
+			}(),
+			wantErr: ErrValidationFailed,
+		},
+		{
+			name: "invalid version",
+			metadata: func() Metadata {
+				metadata := withIdentity(Metadata{})
+				metadata.Version = "latest"
+				return metadata
+			}(),
+			wantErr: ErrValidationFailed,
+		},
+		{
+			name: "missing description",
+			metadata: func() Metadata {
+				metadata := withIdentity(Metadata{})
+				metadata.Description = ""
+				return metadata
+			}(),
+			wantErr: ErrValidationFailed,
+		},
+		{
+			name: "missing maintainers",
+			metadata: func() Metadata {
+				metadata := withIdentity(Metadata{})
+				metadata.Maintainers = nil
+				return metadata
+			}(),
+			wantErr: ErrValidationFailed,
+		},
+		{
+			name: "maintainer without name",
+			metadata: func() Metadata {
+				metadata := withIdentity(Metadata{})
+				metadata.Maintainers = []Maintainer{{Email: "team@example.com"}}
+				return metadata
+			}(),
+			wantErr: ErrValidationFailed,
+		},
+		{
+			name: "maintainer with invalid email",
+			metadata: func() Metadata {
+				metadata := withIdentity(Metadata{})
+				metadata.Maintainers = []Maintainer{{Name: "Platform Team", Email: "not-an-email"}}
+				return metadata
+			}(),
+			wantErr: ErrValidationFailed,
+		},
+		{
+			name: "valid dependencies",
+			metadata: func() Metadata {
+				metadata := withIdentity(Metadata{})
+				metadata.Dependencies = []Dependency{{URI: "local://rulebooks/general", Version: "^1.2.0"}}
+				return metadata
+			}(),
+			wantErr: nil,
+		},
+		{
+			name: "dependency without uri",
+			metadata: func() Metadata {
+				metadata := withIdentity(Metadata{})
+				metadata.Dependencies = []Dependency{{Version: "^1.2.0"}}
+				return metadata
+			}(),
+			wantErr: ErrValidationFailed,
+		},
+		{
+			name: "dependency with invalid version constraint",
+			metadata: func() Metadata {
+				metadata := withIdentity(Metadata{})
+				metadata.Dependencies = []Dependency{{URI: "local://rulebooks/general", Version: "not a constraint"}}
+				return metadata
+			}(),
+			wantErr: ErrValidationFailed,
+		},
+		{
+			name: "missing license",
+			metadata: func() Metadata {
+				metadata := withIdentity(Metadata{})
+				metadata.License = ""
+				return metadata
+			}(),
+			wantErr: ErrValidationFailed,
 		},
 		{
 			name: "invalid instruction URI",
-			metadata: Metadata{
+			metadata: withIdentity(Metadata{
 				AI: &ai.Config{
 					Instruction: &instruction.Config{
 						Sources: []instruction.SourceConfig{
@@ -307,23 +421,23 @@ func TestLoader_validateMetadata(t *testing.T) {
 						},
 					},
 				},
-			},
+			}),
 			wantErr: ErrValidationFailed,
 		},
 		{
 			name: "empty instruction sources",
-			metadata: Metadata{
+			metadata: withIdentity(Metadata{
 				AI: &ai.Config{
 					Instruction: &instruction.Config{
 						Sources: []instruction.SourceConfig{},
 					},
 				},
-			},
+			}),
 			wantErr: ErrValidationFailed,
 		},
 		{
 			name: "valid mcp config",
-			metadata: Metadata{
+			metadata: withIdentity(Metadata{
 				AI: &ai.Config{
 					MCP: &mcp.Config{
 						Sources: []mcp.SourceConfig{
@@ -331,23 +445,23 @@ func TestLoader_validateMetadata(t *testing.T) {
 						},
 					},
 				},
-			},
+			}),
 			wantErr: nil,
 		},
 		{
 			name: "invalid mcp - empty sources",
-			metadata: Metadata{
+			metadata: withIdentity(Metadata{
 				AI: &ai.Config{
 					MCP: &mcp.Config{
 						Sources: []mcp.SourceConfig{},
 					},
 				},
-			},
+			}),
 			wantErr: ErrValidationFailed,
 		},
 		{
 			name: "valid doc config",
-			metadata: Metadata{
+			metadata: withIdentity(Metadata{
 				Doc: &doc.Config{
 					Standard: &standard.Config{
 						Sources: []standard.SourceConfig{
@@ -355,23 +469,23 @@ func TestLoader_validateMetadata(t *testing.T) {
 						},
 					},
 				},
-			},
+			}),
 			wantErr: nil,
 		},
 		{
 			name: "invalid doc config - empty sources",
-			metadata: Metadata{
+			metadata: withIdentity(Metadata{
 				Doc: &doc.Config{
 					Standard: &standard.Config{
 						Sources: []standard.SourceConfig{},
 					},
 				},
-			},
+			}),
 			wantErr: ErrValidationFailed,
 		},
 		{
 			name: "valid workflow config",
-			metadata: Metadata{
+			metadata: withIdentity(Metadata{
 				AI: &ai.Config{
 					Workflows: &workflow.Config{
 						Sources: []workflow.SourceConfig{
@@ -379,18 +493,18 @@ func TestLoader_validateMetadata(t *testing.T) {
 						},
 					},
 				},
-			},
+			}),
 			wantErr: nil,
 		},
 		{
 			name: "invalid workflow config - empty sources",
-			metadata: Metadata{
+			metadata: withIdentity(Metadata{
 				AI: &ai.Config{
 					Workflows: &workflow.Config{
 						Sources: []workflow.SourceConfig{},
 					},
 				},
-			},
+			}),
 			wantErr: ErrValidationFailed,
 		},
 	}
@@ -500,7 +614,7 @@ func TestLoader_Load(t *testing.T) {
 		{
 			name: "instructions and skills loaded",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`ai:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
   instruction:
     sources:
       - uri: rulebook://ai/instructions
@@ -526,12 +640,48 @@ description: "A test skill"`), 0644)
 				assert.Len(t, rb.AI.Instructions[0].Rules, 1)
 				assert.Len(t, rb.AI.Skills, 1)
 				assert.Equal(t, "my-skill", string(rb.AI.Skills[0].Metadata.Name))
+				assert.Equal(t, []source.Provenance{{
+					RulebookName:    "test-rulebook",
+					RulebookVersion: "1.2.3",
+					Path:            "ai/instructions/01-coding.yaml",
+				}}, rb.AI.Instructions[0].Provenance)
+				assert.Equal(t, &source.Provenance{
+					RulebookName:    "test-rulebook",
+					RulebookVersion: "1.2.3",
+					Path:            "ai/skills/my-skill",
+				}, rb.AI.Skills[0].Provenance)
 			},
 		},
 		{
-			name: "only instructions",
+			name: "identity carried from metadata",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity), 0644)
+			},
+			wantErr: false,
+			validate: func(t *testing.T, rb *Rulebook) {
+				require.NotNil(t, rb)
+				assert.Equal(t, "test-rulebook", rb.Name)
+				assert.Equal(t, "1.2.3", rb.Version)
+				assert.Equal(t, "Rulebook used in tests", rb.Description)
+				assert.Equal(t, []Maintainer{{Name: "Platform Team", Email: "platform@example.com"}}, rb.Maintainers)
+				assert.Equal(t, "MIT", rb.License)
+			},
+		},
+		{
+			name: "missing identity",
 			setupFs: func(fs afero.Fs) {
 				_ = afero.WriteFile(fs, rulebookFileName, []byte(`ai:
+  skill:
+    sources:
+      - uri: rulebook://ai/skills`), 0644)
+			},
+			wantErr:    true,
+			errContain: "validate metadata",
+		},
+		{
+			name: "only instructions",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
   instruction:
     sources:
       - uri: rulebook://ai/instructions`), 0644)
@@ -551,7 +701,7 @@ rules:
 		{
 			name: "only skills",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`ai:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
   skill:
     sources:
       - uri: rulebook://ai/skills`), 0644)
@@ -571,7 +721,7 @@ description: "A test skill"`), 0644)
 		{
 			name: "multiple instruction sources",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`ai:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
   instruction:
     sources:
       - uri: rulebook://ai/instructions1
@@ -608,7 +758,7 @@ rules:
 		{
 			name: "metadata validation fails",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`ai:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
   instruction:
     sources: []`), 0644)
 			},
@@ -621,7 +771,7 @@ rules:
 		{
 			name: "instruction URI resolution fails",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`ai:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
   instruction:
     sources:
       - uri: file://invalid/path`), 0644)
@@ -635,7 +785,7 @@ rules:
 		{
 			name: "skill URI resolution fails",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`ai:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
   skill:
     sources:
       - uri: http://invalid/scheme`), 0644)
@@ -649,7 +799,7 @@ rules:
 		{
 			name: "instruction loading fails",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`ai:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
   instruction:
     sources:
       - uri: rulebook://ai/instructions
@@ -673,7 +823,7 @@ description: "A test skill"`), 0644)
 		{
 			name: "skill loading fails",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`ai:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
   instruction:
     sources:
       - uri: rulebook://ai/instructions
@@ -697,7 +847,7 @@ rules:
 		{
 			name: "only standards",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`doc:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`doc:
   standard:
     sources:
       - uri: rulebook://docs/standards`), 0644)
@@ -742,7 +892,7 @@ examples:
 		{
 			name: "instructions, skills and standards",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`ai:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
   instruction:
     sources:
       - uri: rulebook://ai/instructions
@@ -804,7 +954,7 @@ examples:
 		{
 			name: "standard URI resolution fails",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`doc:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`doc:
   standard:
     sources:
       - uri: http://invalid/scheme`), 0644)
@@ -818,7 +968,7 @@ examples:
 		{
 			name: "standard loading fails",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`doc:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`doc:
   standard:
     sources:
       - uri: rulebook://docs/standards`), 0644)
@@ -834,7 +984,7 @@ examples:
 		{
 			name: "only workflows",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`ai:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
   workflow:
     sources:
       - uri: rulebook://ai/workflows`), 0644)
@@ -864,7 +1014,7 @@ steps:
 		{
 			name: "only mcp servers",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`ai:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
   mcp:
     sources:
       - uri: rulebook://ai/mcp`), 0644)
@@ -887,7 +1037,7 @@ stdio:
 		{
 			name: "mcp URI resolution fails",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`ai:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
   mcp:
     sources:
       - uri: http://invalid/scheme`), 0644)
@@ -901,7 +1051,7 @@ stdio:
 		{
 			name: "mcp loading fails",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`ai:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
   mcp:
     sources:
       - uri: rulebook://ai/mcp`), 0644)
@@ -914,10 +1064,53 @@ stdio:
 				assert.Nil(t, rb)
 			},
 		},
+		{
+			name: "only tools",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
+  tool:
+    sources:
+      - uri: rulebook://ai/tools`), 0644)
+
+				_ = fs.MkdirAll("/ai/tools", 0755)
+				_ = afero.WriteFile(fs, "/ai/tools/go-test.yaml", []byte(`metadata:
+  id: go-test
+  name: Go Test
+  description: A tool for running go tests.
+tool:
+  commands:
+    - go test`), 0644)
+			},
+			wantErr: false,
+			validate: func(t *testing.T, rb *Rulebook) {
+				require.NotNil(t, rb)
+				assert.Empty(t, rb.AI.MCPServers)
+				require.Len(t, rb.AI.Tools, 1)
+				assert.Equal(t, "go-test", string(rb.AI.Tools[0].Metadata.ID))
+				assert.Equal(t, "ai/tools/go-test.yaml", rb.AI.Tools[0].Provenance.Path)
+				assert.Equal(t, "test-rulebook", rb.AI.Tools[0].Provenance.RulebookName)
+			},
+		},
+		{
+			name: "tool loading fails",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
+  tool:
+    sources:
+      - uri: rulebook://ai/tools`), 0644)
+
+				_ = fs.MkdirAll("/ai/tools", 0755)
+			},
+			wantErr:    true,
+			errContain: "ai tools: load ai tools",
+			validate: func(t *testing.T, rb *Rulebook) {
+				assert.Nil(t, rb)
+			},
+		},
 		{
 			name: "workflow URI resolution fails",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`ai:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
   workflow:
     sources:
       - uri: http://invalid/scheme`), 0644)
@@ -931,7 +1124,7 @@ stdio:
 		{
 			name: "workflow loading fails",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`ai:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
   workflow:
     sources:
       - uri: rulebook://ai/workflows`), 0644)
@@ -947,7 +1140,7 @@ stdio:
 		{
 			name: "all components loaded",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`ai:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
   instruction:
     sources:
       - uri: rulebook://ai/instructions
@@ -1036,7 +1229,7 @@ examples:
 		{
 			name: "multiple workflow sources",
 			setupFs: func(fs afero.Fs) {
-				_ = afero.WriteFile(fs, rulebookFileName, []byte(`ai:
+				_ = afero.WriteFile(fs, rulebookFileName, []byte(testRulebookIdentity+`ai:
   workflow:
     sources:
       - uri: rulebook://ai/workflows1
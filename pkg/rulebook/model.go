@@ -5,6 +5,7 @@ import (
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/instruction"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/mcp"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/skill"
+	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/tool"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/ai/workflow"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/doc"
 	"github.com/orbiqd/orbiqd-projectkit/pkg/doc/standard"
@@ -15,18 +16,46 @@ type AiRulebook struct {
 	Skills       []skill.Skill
 	Workflows    []workflow.Workflow
 	MCPServers   []mcp.MCPServer
+	Tools        []tool.Tool
 }
 
 type DocRulebook struct {
 	Standards []standard.Standard
 }
 
+// Maintainer identifies a person or team responsible for a rulebook.
+type Maintainer struct {
+	Name  string `json:"name" validate:"required,min=1,max=200"`
+	Email string `json:"email,omitempty" validate:"omitempty,email"`
+}
+
+// Dependency references another rulebook by its source uri and a semver constraint its version must satisfy.
+type Dependency struct {
+	URI     string `json:"uri" validate:"required"`
+	Version string `json:"version" validate:"required"`
+}
+
 type Rulebook struct {
+	Name         string
+	Version      string
+	Description  string
+	Maintainers  []Maintainer
+	License      string
+	Dependencies []Dependency
+
 	AI  AiRulebook
 	Doc DocRulebook
 }
 
 type Metadata struct {
+	Name        string       `json:"name" validate:"required,min=1,max=100"`
+	Version     string       `json:"version" validate:"required,semver"`
+	Description string       `json:"description" validate:"required,min=1,max=500"`
+	Maintainers []Maintainer `json:"maintainers" validate:"required,min=1,dive"`
+	License     string       `json:"license" validate:"required,min=1,max=100"`
+
+	Dependencies []Dependency `json:"dependencies,omitempty" validate:"omitempty,dive"`
+
 	AI  *ai.Config  `json:"ai" validate:"omitempty"`
 	Doc *doc.Config `json:"doc" validate:"omitempty"`
 }
@@ -12,3 +12,6 @@ type Driver interface {
 }
 
 var ErrUnsupportedScheme = errors.New("unsupported scheme")
+
+// ErrSourceNotCached is returned in offline mode when a remote source was never fetched into the cache.
+var ErrSourceNotCached = errors.New("source not cached")
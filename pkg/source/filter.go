@@ -0,0 +1,66 @@
+package source
+
+import "slices"
+
+// Selector matches artifacts by name, id, tag or category. Every non-empty field has to match.
+type Selector struct {
+	Name     string `json:"name,omitempty" validate:"required_without_all=ID Tag Category"`
+	ID       string `json:"id,omitempty"`
+	Tag      string `json:"tag,omitempty"`
+	Category string `json:"category,omitempty"`
+}
+
+// Artifact describes the attributes of a loaded artifact that selectors match against. Fields an artifact
+// type does not have are left empty.
+type Artifact struct {
+	Name     string
+	ID       string
+	Tags     []string
+	Category string
+}
+
+// Matches reports whether the artifact has every attribute set on the selector.
+func (selector Selector) Matches(artifact Artifact) bool {
+	if selector.Name != "" && selector.Name != artifact.Name {
+		return false
+	}
+	if selector.ID != "" && selector.ID != artifact.ID {
+		return false
+	}
+	if selector.Tag != "" && !slices.Contains(artifact.Tags, selector.Tag) {
+		return false
+	}
+	if selector.Category != "" && selector.Category != artifact.Category {
+		return false
+	}
+
+	return true
+}
+
+// Filter narrows down the artifacts taken from a source.
+type Filter struct {
+	// Include keeps only the artifacts matched by at least one selector. An empty list keeps everything.
+	Include []Selector `json:"include,omitempty" validate:"omitempty,dive"`
+
+	// Exclude drops the artifacts matched by any selector, after Include is applied.
+	Exclude []Selector `json:"exclude,omitempty" validate:"omitempty,dive"`
+}
+
+// Allows reports whether the artifact passes the filter.
+func (filter Filter) Allows(artifact Artifact) bool {
+	if len(filter.Include) > 0 && !matchesAny(filter.Include, artifact) {
+		return false
+	}
+
+	return !matchesAny(filter.Exclude, artifact)
+}
+
+func matchesAny(selectors []Selector, artifact Artifact) bool {
+	for _, selector := range selectors {
+		if selector.Matches(artifact) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,77 @@
+package source
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelector_Matches(t *testing.T) {
+	t.Parallel()
+
+	artifact := Artifact{
+		Name:     "Error Handling",
+		ID:       "error-handling",
+		Tags:     []string{"golang", "errors"},
+		Category: "coding",
+	}
+
+	tests := []struct {
+		name     string
+		selector Selector
+		want     bool
+	}{
+		{name: "WhenNameMatches_ThenTrue", selector: Selector{Name: "Error Handling"}, want: true},
+		{name: "WhenIdMatches_ThenTrue", selector: Selector{ID: "error-handling"}, want: true},
+		{name: "WhenTagPresent_ThenTrue", selector: Selector{Tag: "errors"}, want: true},
+		{name: "WhenCategoryMatches_ThenTrue", selector: Selector{Category: "coding"}, want: true},
+		{name: "WhenAllFieldsMatch_ThenTrue", selector: Selector{ID: "error-handling", Tag: "golang", Category: "coding"}, want: true},
+		{name: "WhenOneFieldDiffers_ThenFalse", selector: Selector{ID: "error-handling", Tag: "python"}, want: false},
+		{name: "WhenNameDiffers_ThenFalse", selector: Selector{Name: "logging"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.selector.Matches(artifact))
+		})
+	}
+}
+
+func TestFilter_Allows(t *testing.T) {
+	t.Parallel()
+
+	gitCommit := Artifact{Name: "git-commit"}
+	review := Artifact{Name: "review"}
+
+	tests := []struct {
+		name     string
+		filter   Filter
+		artifact Artifact
+		want     bool
+	}{
+		{name: "WhenEmpty_ThenAllows", filter: Filter{}, artifact: gitCommit, want: true},
+		{name: "WhenIncluded_ThenAllows", filter: Filter{Include: []Selector{{Name: "git-commit"}}}, artifact: gitCommit, want: true},
+		{name: "WhenNotIncluded_ThenRejects", filter: Filter{Include: []Selector{{Name: "git-commit"}}}, artifact: review, want: false},
+		{name: "WhenExcluded_ThenRejects", filter: Filter{Exclude: []Selector{{Name: "git-commit"}}}, artifact: gitCommit, want: false},
+		{name: "WhenNotExcluded_ThenAllows", filter: Filter{Exclude: []Selector{{Name: "git-commit"}}}, artifact: review, want: true},
+		{
+			name: "WhenIncludedAndExcluded_ThenRejects",
+			filter: Filter{
+				Include: []Selector{{Name: "git-commit"}},
+				Exclude: []Selector{{Name: "git-commit"}},
+			},
+			artifact: gitCommit,
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.filter.Allows(tt.artifact))
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package source
+
+import "errors"
+
+// LockFileName is the name of the lockfile written to the project root by the update command.
+const LockFileName = ".projectkit.lock"
+
+// Lock pins every source resolved during an update.
+type Lock struct {
+	Sources []LockedSource `json:"sources" validate:"dive"`
+}
+
+// LockedSource records what a single source uri resolved to.
+type LockedSource struct {
+	URI string `json:"uri" validate:"required"`
+
+	// Revision is the git commit, archive digest or local tree hash the uri resolved to.
+	Revision Revision `json:"revision" validate:"required"`
+
+	// ContentHash is a SHA-256 of every file loaded from the source.
+	ContentHash string `json:"contentHash" validate:"required"`
+}
+
+// FindSource returns the locked entry for the given uri.
+func (lock Lock) FindSource(uri string) (LockedSource, bool) {
+	for _, source := range lock.Sources {
+		if source.URI == uri {
+			return source, true
+		}
+	}
+
+	return LockedSource{}, false
+}
+
+// ErrLockNotFound is returned when the project has no lockfile.
+var ErrLockNotFound = errors.New("lock not found")
+
+// ErrSourceNotLocked is returned in locked mode when a resolved uri is missing from the lockfile.
+var ErrSourceNotLocked = errors.New("source not locked")
+
+// ErrLockMismatch is returned in locked mode when a resolved source differs from its lockfile entry.
+var ErrLockMismatch = errors.New("source does not match lock")
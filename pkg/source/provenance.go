@@ -0,0 +1,60 @@
+package source
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// Provenance records where a stored artifact was loaded from.
+type Provenance struct {
+	// SourceURI is the uri of the source or rulebook the artifact was resolved from.
+	SourceURI string `json:"sourceUri,omitempty"`
+
+	// RulebookName is the name of the rulebook that shipped the artifact, empty for plain sources.
+	RulebookName string `json:"rulebookName,omitempty"`
+
+	// RulebookVersion is the version of the rulebook that shipped the artifact, empty for plain sources.
+	RulebookVersion string `json:"rulebookVersion,omitempty"`
+
+	// Path is the file or directory of the artifact relative to the source root.
+	Path string `json:"path,omitempty"`
+}
+
+// LogValue renders the non-empty provenance fields as a log group.
+func (provenance Provenance) LogValue() slog.Value {
+	var attrs []slog.Attr
+
+	if provenance.SourceURI != "" {
+		attrs = append(attrs, slog.String("sourceUri", provenance.SourceURI))
+	}
+	if provenance.RulebookName != "" {
+		attrs = append(attrs, slog.String("rulebookName", provenance.RulebookName))
+	}
+	if provenance.RulebookVersion != "" {
+		attrs = append(attrs, slog.String("rulebookVersion", provenance.RulebookVersion))
+	}
+	if provenance.Path != "" {
+		attrs = append(attrs, slog.String("path", provenance.Path))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// String describes the provenance for humans, e.g. "ai/skills/git-commit from local://./rulebooks/general
+// (rulebook general@0.1.0)".
+func (provenance Provenance) String() string {
+	description := provenance.SourceURI
+	if description == "" {
+		description = "unknown source"
+	}
+
+	if provenance.Path != "" {
+		description = fmt.Sprintf("%s from %s", provenance.Path, description)
+	}
+
+	if provenance.RulebookName != "" {
+		description = fmt.Sprintf("%s (rulebook %s@%s)", description, provenance.RulebookName, provenance.RulebookVersion)
+	}
+
+	return description
+}
@@ -0,0 +1,97 @@
+package source
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvenance_LogValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		provenance Provenance
+		expected   string
+	}{
+		{
+			name: "WhenRulebookProvenance_ThenLogsAllFields",
+			provenance: Provenance{
+				SourceURI:       "local://./rulebooks/general",
+				RulebookName:    "general",
+				RulebookVersion: "1.0.0",
+				Path:            "ai/skills/git-commit",
+			},
+			expected: "provenance.sourceUri=local://./rulebooks/general provenance.rulebookName=general provenance.rulebookVersion=1.0.0 provenance.path=ai/skills/git-commit",
+		},
+		{
+			name: "WhenPlainSourceProvenance_ThenSkipsEmptyFields",
+			provenance: Provenance{
+				SourceURI: "local://./instructions",
+				Path:      "coding.yaml",
+			},
+			expected: "provenance.sourceUri=local://./instructions provenance.path=coding.yaml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var output bytes.Buffer
+			logger := slog.New(slog.NewTextHandler(&output, &slog.HandlerOptions{
+				ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+					if len(groups) == 0 && (attr.Key == slog.TimeKey || attr.Key == slog.LevelKey || attr.Key == slog.MessageKey) {
+						return slog.Attr{}
+					}
+					return attr
+				},
+			}))
+
+			logger.Info("", slog.Any("provenance", tt.provenance))
+
+			assert.Equal(t, tt.expected+"\n", output.String())
+		})
+	}
+}
+
+func TestProvenance_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		provenance Provenance
+		expected   string
+	}{
+		{
+			name: "WhenRulebookProvenance_ThenDescribesRulebook",
+			provenance: Provenance{
+				SourceURI:       "local://./rulebooks/general",
+				RulebookName:    "general",
+				RulebookVersion: "0.1.0",
+				Path:            "ai/skills/git-commit",
+			},
+			expected: "ai/skills/git-commit from local://./rulebooks/general (rulebook general@0.1.0)",
+		},
+		{
+			name:       "WhenPlainSourceProvenance_ThenDescribesPathAndSource",
+			provenance: Provenance{SourceURI: "local://./skills", Path: "git-commit"},
+			expected:   "git-commit from local://./skills",
+		},
+		{
+			name:       "WhenEmpty_ThenReportsUnknownSource",
+			provenance: Provenance{},
+			expected:   "unknown source",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, tt.provenance.String())
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package source
+
+import "github.com/spf13/afero"
+
+// Revision identifies the exact content a source uri was resolved to, e.g. a git commit or an archive digest.
+type Revision string
+
+// RevisionedFs is a resolved source filesystem that knows the revision it was resolved at.
+type RevisionedFs interface {
+	afero.Fs
+
+	// Revision returns the revision the filesystem was resolved at.
+	Revision() Revision
+}
+
+type revisionedFs struct {
+	afero.Fs
+	revision Revision
+}
+
+func (fs *revisionedFs) Revision() Revision {
+	return fs.revision
+}
+
+// NewRevisionedFs decorates a resolved filesystem with the revision it was resolved at.
+func NewRevisionedFs(fs afero.Fs, revision Revision) RevisionedFs {
+	return &revisionedFs{
+		Fs:       fs,
+		revision: revision,
+	}
+}
+
+// RevisionOf returns the revision of a resolved filesystem if its driver reported one.
+func RevisionOf(fs afero.Fs) (Revision, bool) {
+	revisioned, ok := fs.(RevisionedFs)
+	if !ok {
+		return "", false
+	}
+
+	return revisioned.Revision(), true
+}